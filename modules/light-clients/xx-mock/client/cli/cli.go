@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/datachainlab/ibc-mock-client/modules/light-clients/xx-mock/types"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the query commands for Mock clients
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "IBC mock client query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryLatestHeight(),
+		GetCmdQueryConsensusStates(),
+		GetCmdQueryConsensusState(),
+		GetCmdQueryProof(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the command to create and update Mock clients
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "IBC mock client transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCreateClientCmd(),
+		NewUpdateClientCmd(),
+	)
+
+	return txCmd
+}
@@ -0,0 +1,196 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/datachainlab/ibc-mock-client/modules/light-clients/xx-mock/types"
+	"github.com/spf13/cobra"
+)
+
+const flagExtended = "extended"
+
+// GetCmdQueryLatestHeight defines the command to query the latest height of a Mock client.
+func GetCmdQueryLatestHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "latest-height [client-id]",
+		Short:   "Query the latest height of a mock client",
+		Long:    "Query the latest height of a mock client",
+		Example: fmt.Sprintf("%s query %s latest-height [client-id]", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientState, err := queryClientState(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&clientState.LatestHeight)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConsensusStates defines the command to query all the consensus states of a Mock client
+// together with their processed time and height.
+func GetCmdQueryConsensusStates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "consensus-states [client-id]",
+		Short:   "Query all the consensus states of a mock client with their metadata",
+		Long:    "Query all the consensus states of a mock client with their timestamps and processed time and height",
+		Example: fmt.Sprintf("%s query %s consensus-states [client-id]", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsensusStatesRequest{
+				ClientId:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ConsensusStates(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consensus states")
+
+	return cmd
+}
+
+// GetCmdQueryConsensusState defines the command to query the consensus state of a Mock client
+// at or before the given height together with its processed time and height.
+func GetCmdQueryConsensusState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-state [client-id] [height]",
+		Short: "Query the consensus state of a mock client at or before a height",
+		Long: `Query the consensus state of a mock client at the given height, or the latest one before the height if no consensus state exists at that height.
+The height must be formatted as {revision}-{height}.`,
+		Example: fmt.Sprintf("%s query %s consensus-state [client-id] 0-100", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := clienttypes.ParseHeight(args[1])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsensusStateRequest{
+				ClientId:       args[0],
+				RevisionNumber: height.RevisionNumber,
+				RevisionHeight: height.RevisionHeight,
+			}
+
+			res, err := queryClient.ConsensusState(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProof defines the command to compute the membership proof that a Mock client expects
+// for the given prefix, path, value and height.
+func GetCmdQueryProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof [client-id] [prefix] [path] [value] [height]",
+		Short: "Compute the expected membership proof",
+		Long: `Compute the membership proof that the mock client expects for the value at the given commitment prefix, ICS-24 path and proof height.
+The value must be hex-encoded, and the height must be formatted as {revision}-{height}.
+With --extended, the proof is returned in the extended encoding, for which the client reports which component mismatched on a verification failure.`,
+		Example: fmt.Sprintf("%s query %s proof [client-id] ibc connections/connection-0 0a0f... 0-100", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			value, err := hex.DecodeString(strings.TrimPrefix(args[3], "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid value: %w", err)
+			}
+			height, err := clienttypes.ParseHeight(args[4])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMembershipProofRequest{
+				ClientId:    args[0],
+				Prefix:      []byte(args[1]),
+				Path:        args[2],
+				Value:       value,
+				ProofHeight: height,
+			}
+			req.Extended, err = cmd.Flags().GetBool(flagExtended)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MembershipProof(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%X\n", res.Proof))
+		},
+	}
+	cmd.Flags().Bool(flagExtended, false, "return the proof in the extended encoding")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// queryClientState queries the client state of the given client and ensures that it is a Mock client state.
+func queryClientState(cmd *cobra.Command, clientCtx client.Context, clientID string) (*types.ClientState, error) {
+	queryClient := clienttypes.NewQueryClient(clientCtx)
+	res, err := queryClient.ClientState(cmd.Context(), &clienttypes.QueryClientStateRequest{ClientId: clientID})
+	if err != nil {
+		return nil, err
+	}
+
+	clientState, err := clienttypes.UnpackClientState(res.ClientState)
+	if err != nil {
+		return nil, err
+	}
+	mockClientState, ok := clientState.(*types.ClientState)
+	if !ok {
+		return nil, fmt.Errorf("client %s is not a mock client: expected client state type %T, got %T", clientID, &types.ClientState{}, clientState)
+	}
+	return mockClientState, nil
+}
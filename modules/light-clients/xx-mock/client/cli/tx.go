@@ -0,0 +1,536 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/datachainlab/ibc-mock-client/modules/light-clients/xx-mock/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagRevisionNumber         = "revision-number"
+	flagRevisionHeight         = "revision-height"
+	flagTimestamp              = "timestamp"
+	flagTrustingPeriod         = "trusting-period"
+	flagMaxClockDrift          = "max-clock-drift"
+	flagPruneKeepLast          = "prune-keep-last"
+	flagPruneMaxAge            = "prune-max-age"
+	flagAdvance                = "advance"
+	flagAuthorityPubKey        = "authority-pubkey"
+	flagAuthorityKey           = "authority-key"
+	flagNewAuthority           = "new-authority-pubkey"
+	flagCommitmentScheme       = "commitment-scheme"
+	flagHashAlgorithm          = "hash-algorithm"
+	flagRoot                   = "root"
+	flagCommittedNonMembership = "committed-non-membership"
+	flagPathPolicy             = "path-policy"
+	flagStatusSchedule         = "status-schedule"
+
+	timestampNow = "now"
+
+	commitmentSchemeHash  = "hash"
+	commitmentSchemeICS23 = "ics23"
+)
+
+// NewCreateClientCmd defines the command to create a new Mock client.
+func NewCreateClientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "create new mock client",
+		Long: `create a new mock client whose latest height is the given revision number and height.
+The timestamp of the initial consensus state is given in nanoseconds since the UNIX epoch, in RFC3339 format or as "now".`,
+		Example: fmt.Sprintf("%s tx %s create --revision-number 0 --revision-height 1 --timestamp now --from node0", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			revisionNumber, err := cmd.Flags().GetUint64(flagRevisionNumber)
+			if err != nil {
+				return err
+			}
+			revisionHeight, err := cmd.Flags().GetUint64(flagRevisionHeight)
+			if err != nil {
+				return err
+			}
+			timestamp, err := parseTimestampFlag(cmd)
+			if err != nil {
+				return err
+			}
+			trustingPeriod, err := cmd.Flags().GetDuration(flagTrustingPeriod)
+			if err != nil {
+				return err
+			}
+			maxClockDrift, err := cmd.Flags().GetDuration(flagMaxClockDrift)
+			if err != nil {
+				return err
+			}
+			pruneKeepLast, err := cmd.Flags().GetUint64(flagPruneKeepLast)
+			if err != nil {
+				return err
+			}
+			pruneMaxAge, err := cmd.Flags().GetDuration(flagPruneMaxAge)
+			if err != nil {
+				return err
+			}
+
+			clientState := types.NewClientState(clienttypes.NewHeight(revisionNumber, revisionHeight))
+			clientState.TrustingPeriod = trustingPeriod
+			clientState.MaxClockDrift = maxClockDrift
+			clientState.PruningPolicy = types.PruningPolicy{
+				KeepLast: pruneKeepLast,
+				MaxAge:   pruneMaxAge,
+			}
+			clientState.PublicKey, err = parsePubKeyFlag(cmd, clientCtx, flagAuthorityPubKey)
+			if err != nil {
+				return err
+			}
+			clientState.CommitmentScheme, err = parseCommitmentSchemeFlag(cmd)
+			if err != nil {
+				return err
+			}
+			clientState.HashAlgorithm, err = parseHashAlgorithmFlag(cmd)
+			if err != nil {
+				return err
+			}
+			clientState.CommittedNonMembership, err = cmd.Flags().GetBool(flagCommittedNonMembership)
+			if err != nil {
+				return err
+			}
+			clientState.PathPolicies, err = parsePathPolicyFlag(cmd)
+			if err != nil {
+				return err
+			}
+			clientState.StatusSchedule, err = parseStatusScheduleFlag(cmd)
+			if err != nil {
+				return err
+			}
+			root, err := parseRootFlag(cmd)
+			if err != nil {
+				return err
+			}
+			consensusState := &types.ConsensusState{
+				Timestamp: timestamp,
+				Root:      root,
+			}
+
+			msg, err := clienttypes.NewMsgCreateClient(clientState, consensusState, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagRevisionNumber, 0, "revision number of the latest height")
+	cmd.Flags().Uint64(flagRevisionHeight, 1, "revision height of the latest height")
+	cmd.Flags().String(flagTimestamp, timestampNow, "timestamp of the initial consensus state")
+	cmd.Flags().Duration(flagTrustingPeriod, 0, "trusting period of the client (zero means the client never expires)")
+	cmd.Flags().Duration(flagMaxClockDrift, 0, "max duration by which header timestamps may exceed the block time (zero means no limit)")
+	cmd.Flags().Uint64(flagPruneKeepLast, 0, "number of the latest consensus states kept on updates (zero means no limit)")
+	cmd.Flags().Duration(flagPruneMaxAge, 0, "max age of the consensus states kept on updates, measured from their timestamps to the block time (zero means no limit)")
+	cmd.Flags().String(flagCommitmentScheme, commitmentSchemeHash, fmt.Sprintf("commitment scheme of the proofs verified by the client (%s or %s)", commitmentSchemeHash, commitmentSchemeICS23))
+	cmd.Flags().String(flagHashAlgorithm, "sha256", "hash algorithm of the proof commitments (one of sha256, keccak256, blake2b-256 and sha512-256)")
+	cmd.Flags().String(flagRoot, "", "hex-encoded commitment root of the initial consensus state, required by the ics23 commitment scheme")
+	cmd.Flags().StringSlice(flagPathPolicy, nil, "verification policies for path kinds formatted as {path-kind}={policy}, e.g. packet-acknowledgement=always-accept (policy is one of verify, always-accept and always-reject)")
+	cmd.Flags().StringSlice(flagStatusSchedule, nil, "scheduled status transitions formatted as {status}@{self-height} or {status}@{RFC3339 block time}, e.g. frozen@0-100 (status is one of active, frozen, expired and unknown)")
+	cmd.Flags().Bool(flagCommittedNonMembership, false, "require non-membership proofs committing to the height and path instead of the empty proof")
+	cmd.Flags().String(flagAuthorityPubKey, "", "authority public key in JSON which must sign the headers, e.g. the output of 'keys show --pubkey', which may be a multisig key (unsigned headers are accepted if empty)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUpdateClientCmd defines the command to update a Mock client with a new header.
+func NewUpdateClientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [client-id]",
+		Short: "update existing mock client",
+		Long: `update an existing mock client with a header at the given height.
+If the revision number is not given, the revision number of the latest height of the client is used.
+The timestamp of the header is given in nanoseconds since the UNIX epoch, in RFC3339 format or as "now".
+With --advance N, N headers following the latest height of the client are submitted with generated timestamps instead.
+If the client has an authority public key, the headers must be signed with the keyring key given by --authority-key.
+For a multisig authority, --authority-key is a comma-separated list of the keyring keys of at least threshold signers.
+With --new-authority-pubkey, the authority is rotated by the last submitted header.`,
+		Example: fmt.Sprintf("%s tx %s update [client-id] --revision-height 10 --timestamp now --from node0", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientID := args[0]
+
+			advance, err := cmd.Flags().GetUint64(flagAdvance)
+			if err != nil {
+				return err
+			}
+
+			var headers []*types.Header
+			if advance > 0 {
+				if cmd.Flags().Changed(flagRevisionHeight) || cmd.Flags().Changed(flagTimestamp) || cmd.Flags().Changed(flagRoot) {
+					return fmt.Errorf("--%s cannot be used with --%s, --%s or --%s", flagAdvance, flagRevisionHeight, flagTimestamp, flagRoot)
+				}
+				headers, err = advanceHeaders(cmd, clientCtx, clientID, advance)
+				if err != nil {
+					return err
+				}
+			} else {
+				header, err := headerFromFlags(cmd, clientCtx, clientID)
+				if err != nil {
+					return err
+				}
+				headers = append(headers, header)
+			}
+
+			headers[len(headers)-1].NewPublicKey, err = parsePubKeyFlag(cmd, clientCtx, flagNewAuthority)
+			if err != nil {
+				return err
+			}
+
+			keyNames, err := cmd.Flags().GetStringSlice(flagAuthorityKey)
+			if err != nil {
+				return err
+			}
+			if len(keyNames) > 0 {
+				if err := signHeaders(cmd, clientCtx, clientID, keyNames, headers); err != nil {
+					return err
+				}
+			}
+
+			msgs := make([]sdk.Msg, len(headers))
+			for i, header := range headers {
+				msg, err := clienttypes.NewMsgUpdateClient(clientID, header, clientCtx.GetFromAddress().String())
+				if err != nil {
+					return err
+				}
+				msgs[i] = msg
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().Uint64(flagRevisionNumber, 0, "revision number of the header (defaults to the revision number of the client)")
+	cmd.Flags().Uint64(flagRevisionHeight, 0, "revision height of the header")
+	cmd.Flags().String(flagTimestamp, timestampNow, "timestamp of the header")
+	cmd.Flags().String(flagRoot, "", "hex-encoded commitment root of the header, required by the ics23 commitment scheme")
+	cmd.Flags().Uint64(flagAdvance, 0, "advance the client by the given number of heights with generated timestamps")
+	cmd.Flags().StringSlice(flagAuthorityKey, nil, "names of the keyring keys of the authority to sign the headers with")
+	cmd.Flags().String(flagNewAuthority, "", "new authority public key in JSON to rotate the authority to")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// headerFromFlags builds a header from the height and timestamp flags.
+func headerFromFlags(cmd *cobra.Command, clientCtx client.Context, clientID string) (*types.Header, error) {
+	if !cmd.Flags().Changed(flagRevisionHeight) {
+		return nil, fmt.Errorf("either --%s or --%s must be specified", flagRevisionHeight, flagAdvance)
+	}
+	revisionHeight, err := cmd.Flags().GetUint64(flagRevisionHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var revisionNumber uint64
+	if cmd.Flags().Changed(flagRevisionNumber) {
+		revisionNumber, err = cmd.Flags().GetUint64(flagRevisionNumber)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		clientState, err := queryClientState(cmd, clientCtx, clientID)
+		if err != nil {
+			return nil, err
+		}
+		revisionNumber = clientState.LatestHeight.RevisionNumber
+	}
+
+	timestamp, err := parseTimestampFlag(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := parseRootFlag(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Header{
+		Height:    clienttypes.NewHeight(revisionNumber, revisionHeight),
+		Timestamp: timestamp,
+		Root:      root,
+	}, nil
+}
+
+// advanceHeaders builds the given number of headers following the latest height of the client.
+// The timestamps of the headers increase by one nanosecond and the last one is the current time,
+// unless the latest consensus state of the client has a later timestamp.
+func advanceHeaders(cmd *cobra.Command, clientCtx client.Context, clientID string, advance uint64) ([]*types.Header, error) {
+	clientState, err := queryClientState(cmd, clientCtx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	latestHeight := clientState.LatestHeight
+
+	queryClient := clienttypes.NewQueryClient(clientCtx)
+	res, err := queryClient.ConsensusState(cmd.Context(), &clienttypes.QueryConsensusStateRequest{
+		ClientId:       clientID,
+		RevisionNumber: latestHeight.RevisionNumber,
+		RevisionHeight: latestHeight.RevisionHeight,
+	})
+	if err != nil {
+		return nil, err
+	}
+	consensusState, err := clienttypes.UnpackConsensusState(res.ConsensusState)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp := uint64(time.Now().UnixNano()) - (advance - 1)
+	if latestTimestamp := consensusState.GetTimestamp(); timestamp <= latestTimestamp {
+		timestamp = latestTimestamp + 1
+	}
+
+	headers := make([]*types.Header, advance)
+	for i := uint64(0); i < advance; i++ {
+		headers[i] = &types.Header{
+			Height:    clienttypes.NewHeight(latestHeight.RevisionNumber, latestHeight.RevisionHeight+i+1),
+			Timestamp: timestamp + i,
+		}
+	}
+	return headers, nil
+}
+
+// signHeaders signs the headers with the given keys of the keyring.
+// If the authority of the client is a multisig key, the signatures of the keys are
+// combined into a multisignature ordered as the signers of the authority.
+func signHeaders(cmd *cobra.Command, clientCtx client.Context, clientID string, keyNames []string, headers []*types.Header) error {
+	clientState, err := queryClientState(cmd, clientCtx, clientID)
+	if err != nil {
+		return err
+	}
+	publicKey, err := clientState.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if publicKey == nil {
+		return fmt.Errorf("client %s has no authority public key", clientID)
+	}
+
+	multisigKey, isMultisig := publicKey.(*multisig.LegacyAminoPubKey)
+	if !isMultisig && len(keyNames) != 1 {
+		return fmt.Errorf("exactly one key must be given for a single authority, got %d", len(keyNames))
+	}
+
+	for _, header := range headers {
+		signBytes, err := header.GetSignBytes()
+		if err != nil {
+			return err
+		}
+		if !isMultisig {
+			header.Signature, _, err = clientCtx.Keyring.Sign(keyNames[0], signBytes)
+			if err != nil {
+				return fmt.Errorf("failed to sign header at height %s: %w", header.Height, err)
+			}
+			continue
+		}
+
+		signers := multisigKey.GetPubKeys()
+		multiSignature := cryptotypes.MultiSignature{Signatures: make([][]byte, len(signers))}
+		for _, keyName := range keyNames {
+			signature, signer, err := clientCtx.Keyring.Sign(keyName, signBytes)
+			if err != nil {
+				return fmt.Errorf("failed to sign header at height %s: %w", header.Height, err)
+			}
+			index := signerIndex(signers, signer)
+			if index < 0 {
+				return fmt.Errorf("key %s is not a signer of the authority of client %s", keyName, clientID)
+			}
+			multiSignature.Signatures[index] = signature
+		}
+		header.Signature, err = multiSignature.Marshal()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// signerIndex returns the index of the public key in the signers, or -1 if it is not found.
+func signerIndex(signers []cryptotypes.PubKey, publicKey cryptotypes.PubKey) int {
+	for i, signer := range signers {
+		if signer.Equals(publicKey) {
+			return i
+		}
+	}
+	return -1
+}
+
+// parsePubKeyFlag parses the public key in JSON given by the flag into an Any.
+// A nil Any is returned if the flag is empty.
+func parsePubKeyFlag(cmd *cobra.Command, clientCtx client.Context, flag string) (*codectypes.Any, error) {
+	pubKeyJSON, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+	if pubKeyJSON == "" {
+		return nil, nil
+	}
+	var pubKey cryptotypes.PubKey
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(pubKeyJSON), &pubKey); err != nil {
+		return nil, fmt.Errorf("invalid public key given by --%s: %w", flag, err)
+	}
+	return codectypes.NewAnyWithValue(pubKey)
+}
+
+// parseCommitmentSchemeFlag parses the commitment scheme flag.
+func parseCommitmentSchemeFlag(cmd *cobra.Command) (types.CommitmentScheme, error) {
+	value, err := cmd.Flags().GetString(flagCommitmentScheme)
+	if err != nil {
+		return 0, err
+	}
+	switch value {
+	case commitmentSchemeHash:
+		return types.CommitmentSchemeHash, nil
+	case commitmentSchemeICS23:
+		return types.CommitmentSchemeICS23, nil
+	default:
+		return 0, fmt.Errorf("invalid commitment scheme %q: must be %q or %q", value, commitmentSchemeHash, commitmentSchemeICS23)
+	}
+}
+
+// parseHashAlgorithmFlag parses the hash algorithm flag, which is the name of the enum value in lower kebab case
+// without its prefix.
+func parseHashAlgorithmFlag(cmd *cobra.Command) (types.HashAlgorithm, error) {
+	value, err := cmd.Flags().GetString(flagHashAlgorithm)
+	if err != nil {
+		return 0, err
+	}
+	hashAlgorithm, ok := types.HashAlgorithm_value[enumName("HASH_ALGORITHM_", value)]
+	if !ok {
+		return 0, fmt.Errorf("invalid hash algorithm %q", value)
+	}
+	return types.HashAlgorithm(hashAlgorithm), nil
+}
+
+// parsePathPolicyFlag parses the path policy flag, whose elements are formatted as {path-kind}={policy}.
+// The path kinds and the policies are the names of the enum values in lower kebab case without their prefixes.
+func parsePathPolicyFlag(cmd *cobra.Command) ([]types.PathPolicy, error) {
+	values, err := cmd.Flags().GetStringSlice(flagPathPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []types.PathPolicy
+	for _, value := range values {
+		kind, policy, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid path policy %q: must be formatted as {path-kind}={policy}", value)
+		}
+		pathKind, ok := types.PathKind_value[enumName("PATH_KIND_", kind)]
+		if !ok {
+			return nil, fmt.Errorf("invalid path kind %q", kind)
+		}
+		verificationPolicy, ok := types.VerificationPolicy_value[enumName("VERIFICATION_POLICY_", policy)]
+		if !ok {
+			return nil, fmt.Errorf("invalid verification policy %q", policy)
+		}
+		policies = append(policies, types.PathPolicy{
+			PathKind: types.PathKind(pathKind),
+			Policy:   types.VerificationPolicy(verificationPolicy),
+		})
+	}
+	return policies, nil
+}
+
+// parseStatusScheduleFlag parses the status schedule flag, whose elements are formatted as
+// {status}@{self-height} or {status}@{RFC3339 block time}.
+func parseStatusScheduleFlag(cmd *cobra.Command) ([]types.StatusTransition, error) {
+	values, err := cmd.Flags().GetStringSlice(flagStatusSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedule []types.StatusTransition
+	for _, value := range values {
+		status, trigger, ok := strings.Cut(value, "@")
+		if !ok {
+			return nil, fmt.Errorf("invalid status transition %q: must be formatted as {status}@{self-height} or {status}@{block-time}", value)
+		}
+		scheduledStatus, ok := types.ScheduledStatus_value[enumName("SCHEDULED_STATUS_", status)]
+		if !ok {
+			return nil, fmt.Errorf("invalid scheduled status %q", status)
+		}
+
+		transition := types.StatusTransition{Status: types.ScheduledStatus(scheduledStatus)}
+		if height, err := clienttypes.ParseHeight(trigger); err == nil {
+			transition.SelfHeight = height
+		} else if blockTime, err := time.Parse(time.RFC3339Nano, trigger); err == nil {
+			transition.BlockTime = blockTime
+		} else {
+			return nil, fmt.Errorf("invalid trigger %q: must be a height formatted as {revision}-{height} or a RFC3339 time", trigger)
+		}
+		schedule = append(schedule, transition)
+	}
+	return schedule, nil
+}
+
+// enumName converts the lower kebab case name to the name of the enum value with the given prefix.
+func enumName(prefix, name string) string {
+	return prefix + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
+// parseRootFlag parses the hex-encoded commitment root flag.
+func parseRootFlag(cmd *cobra.Command) ([]byte, error) {
+	value, err := cmd.Flags().GetString(flagRoot)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, nil
+	}
+	root, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid commitment root: %w", err)
+	}
+	return root, nil
+}
+
+// parseTimestampFlag parses the timestamp flag, which is either nanoseconds since the UNIX epoch,
+// a RFC3339 formatted time or "now".
+func parseTimestampFlag(cmd *cobra.Command) (uint64, error) {
+	value, err := cmd.Flags().GetString(flagTimestamp)
+	if err != nil {
+		return 0, err
+	}
+	if value == timestampNow {
+		return uint64(time.Now().UnixNano()), nil
+	}
+	if timestamp, err := strconv.ParseUint(value, 10, 64); err == nil {
+		return timestamp, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp %q: must be nanoseconds, RFC3339 or %q", value, timestampNow)
+	}
+	return uint64(t.UnixNano()), nil
+}
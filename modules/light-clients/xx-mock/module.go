@@ -1,10 +1,15 @@
 package mock
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/datachainlab/ibc-mock-client/modules/light-clients/xx-mock/client/cli"
 	"github.com/datachainlab/ibc-mock-client/modules/light-clients/xx-mock/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
@@ -35,14 +40,74 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 	types.RegisterInterfaces(registry)
 }
 
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {}
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the mock client module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 // GetTxCmd returns the capability module's root tx command.
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.NewTxCmd()
 }
 
 // GetQueryCmd returns the capability module's root query command.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return nil
+	return cli.GetQueryCmd()
+}
+
+// ----------------------------------------------------------------------------
+// AppModule
+// ----------------------------------------------------------------------------
+
+var (
+	_ module.AppModule           = AppModule{}
+	_ module.HasServices         = AppModule{}
+	_ module.HasConsensusVersion = AppModule{}
+)
+
+// AppModule implements the AppModule interface for the mock client module.
+// It serves the gRPC queries over the client stores managed by the IBC client keeper,
+// and migrates the client stores of existing Mock clients.
+type AppModule struct {
+	AppModuleBasic
+
+	cdc          codec.BinaryCodec
+	clientKeeper types.ClientKeeper
 }
+
+// NewAppModule creates a new AppModule instance.
+func NewAppModule(cdc codec.BinaryCodec, clientKeeper types.ClientKeeper) AppModule {
+	return AppModule{
+		cdc:          cdc,
+		clientKeeper: clientKeeper,
+	}
+}
+
+// RegisterServices registers module services and store migrations.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterQueryServer(cfg.QueryServer(), types.NewQuerier(am.cdc, am.clientKeeper))
+
+	if err := cfg.RegisterMigration(types.ModuleName, 1, am.migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate %s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, am.migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate %s from version 2 to 3: %v", types.ModuleName, err))
+	}
+}
+
+// migrate1to2 indexes the consensus states of the Mock clients for ordered iteration.
+func (am AppModule) migrate1to2(ctx sdk.Context) error {
+	_, err := types.MigrateConsensusStateIndex(ctx, am.clientKeeper)
+	return err
+}
+
+// migrate2to3 rewrites the processed heights of the Mock clients into the binary encoding.
+func (am AppModule) migrate2to3(ctx sdk.Context) error {
+	_, err := types.MigrateProcessedHeights(ctx, am.clientKeeper)
+	return err
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 3 }
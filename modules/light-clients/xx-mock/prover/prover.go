@@ -0,0 +1,161 @@
+package prover
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/datachainlab/ibc-mock-client/modules/light-clients/xx-mock/types"
+)
+
+// Prover generates the proofs that are accepted by a Mock client with the hash commitment scheme.
+// It shares the proof construction with the verification functions of the given client state,
+// so that the produced proofs are always consistent with the verifier.
+// ICS-23 proofs must be queried from the counterparty store instead, and the methods return an error for such clients.
+type Prover struct {
+	clientState *types.ClientState
+}
+
+// NewProver creates a new Prover instance for the given client state.
+func NewProver(clientState *types.ClientState) *Prover {
+	return &Prover{
+		clientState: clientState,
+	}
+}
+
+// ProveMembership returns a proof of the existence of the value at the ICS-24 path under the commitment prefix at the given height.
+func (p Prover) ProveMembership(prefix exported.Prefix, path string, value []byte, height clienttypes.Height) ([]byte, error) {
+	merklePath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return nil, err
+	}
+	return p.clientState.MembershipProof(height, merklePath, value)
+}
+
+// ProveNonMembership returns a proof of the absence of the ICS-24 path under the commitment prefix at the given height.
+func (p Prover) ProveNonMembership(prefix exported.Prefix, path string, height clienttypes.Height) ([]byte, error) {
+	merklePath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return nil, err
+	}
+	return p.clientState.NonMembershipProof(height, merklePath)
+}
+
+// ProveMembershipExtended returns a proof of the existence of the value in the extended encoding,
+// for which the client reports which of the height, prefix, path or value mismatched if the verification fails.
+func (p Prover) ProveMembershipExtended(prefix exported.Prefix, path string, value []byte, height clienttypes.Height) ([]byte, error) {
+	merklePath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return nil, err
+	}
+	return p.clientState.ExtendedMembershipProof(height, merklePath, value)
+}
+
+// ProveMembershipPreimage returns a proof of the existence of the value in the extended encoding carrying the value itself,
+// for which the client reports a field-level diff of the decoded values if the value does not match.
+func (p Prover) ProveMembershipPreimage(prefix exported.Prefix, path string, value []byte, height clienttypes.Height) ([]byte, error) {
+	merklePath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return nil, err
+	}
+	return p.clientState.PreimageMembershipProof(height, merklePath, value)
+}
+
+// ProveNonMembershipExtended returns a committed proof of the absence of the ICS-24 path in the extended encoding.
+func (p Prover) ProveNonMembershipExtended(prefix exported.Prefix, path string, height clienttypes.Height) ([]byte, error) {
+	merklePath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return nil, err
+	}
+	return p.clientState.ExtendedNonMembershipProof(height, merklePath)
+}
+
+// ProveClientState returns a proof of the client state stored for the given client identifier.
+func (p Prover) ProveClientState(
+	cdc codec.BinaryCodec, prefix exported.Prefix, clientID string, clientState exported.ClientState, height clienttypes.Height,
+) ([]byte, error) {
+	bz, err := cdc.MarshalInterface(clientState)
+	if err != nil {
+		return nil, err
+	}
+	return p.ProveMembership(prefix, host.FullClientStatePath(clientID), bz, height)
+}
+
+// ProveConsensusState returns a proof of the consensus state stored for the given client identifier and consensus height.
+func (p Prover) ProveConsensusState(
+	cdc codec.BinaryCodec, prefix exported.Prefix, clientID string, consensusHeight exported.Height, consensusState exported.ConsensusState, height clienttypes.Height,
+) ([]byte, error) {
+	bz, err := cdc.MarshalInterface(consensusState)
+	if err != nil {
+		return nil, err
+	}
+	return p.ProveMembership(prefix, host.FullConsensusStatePath(clientID, consensusHeight), bz, height)
+}
+
+// ProveConnection returns a proof of the connection end stored for the given connection identifier.
+func (p Prover) ProveConnection(
+	cdc codec.BinaryCodec, prefix exported.Prefix, connectionID string, connection connectiontypes.ConnectionEnd, height clienttypes.Height,
+) ([]byte, error) {
+	bz, err := cdc.Marshal(&connection)
+	if err != nil {
+		return nil, err
+	}
+	return p.ProveMembership(prefix, host.ConnectionPath(connectionID), bz, height)
+}
+
+// ProveChannel returns a proof of the channel end stored for the given port and channel identifiers.
+func (p Prover) ProveChannel(
+	cdc codec.BinaryCodec, prefix exported.Prefix, portID, channelID string, channel channeltypes.Channel, height clienttypes.Height,
+) ([]byte, error) {
+	bz, err := cdc.Marshal(&channel)
+	if err != nil {
+		return nil, err
+	}
+	return p.ProveMembership(prefix, host.ChannelPath(portID, channelID), bz, height)
+}
+
+// ProvePacketCommitment returns a proof of the commitment of the given packet stored by the sending chain.
+func (p Prover) ProvePacketCommitment(
+	cdc codec.BinaryCodec, prefix exported.Prefix, packet exported.PacketI, height clienttypes.Height,
+) ([]byte, error) {
+	commitment := channeltypes.CommitPacket(cdc, packet)
+	path := host.PacketCommitmentPath(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	return p.ProveMembership(prefix, path, commitment, height)
+}
+
+// ProvePacketAcknowledgement returns a proof of the acknowledgement written by the receiving chain for the given sequence.
+func (p Prover) ProvePacketAcknowledgement(
+	prefix exported.Prefix, portID, channelID string, sequence uint64, acknowledgement []byte, height clienttypes.Height,
+) ([]byte, error) {
+	path := host.PacketAcknowledgementPath(portID, channelID, sequence)
+	return p.ProveMembership(prefix, path, channeltypes.CommitAcknowledgement(acknowledgement), height)
+}
+
+// ProvePacketReceiptAbsence returns a proof of the absence of the packet receipt for the given sequence.
+func (p Prover) ProvePacketReceiptAbsence(
+	prefix exported.Prefix, portID, channelID string, sequence uint64, height clienttypes.Height,
+) ([]byte, error) {
+	return p.ProveNonMembership(prefix, host.PacketReceiptPath(portID, channelID, sequence), height)
+}
+
+// ProveNextSequenceRecv returns a proof of the next sequence to be received on the given channel.
+func (p Prover) ProveNextSequenceRecv(
+	prefix exported.Prefix, portID, channelID string, nextSequenceRecv uint64, height clienttypes.Height,
+) ([]byte, error) {
+	path := host.NextSequenceRecvPath(portID, channelID)
+	return p.ProveMembership(prefix, path, sdk.Uint64ToBigEndian(nextSequenceRecv), height)
+}
+
+// ProveUpgradedClient returns a proof of the upgraded client committed by the counterparty at the last height before the upgrade.
+func (p Prover) ProveUpgradedClient(cdc codec.BinaryCodec, upgradedClient exported.ClientState, lastHeight clienttypes.Height) ([]byte, error) {
+	return p.clientState.UpgradedClientProof(cdc, upgradedClient, lastHeight)
+}
+
+// ProveUpgradedConsState returns a proof of the upgraded consensus state committed by the counterparty at the last height before the upgrade.
+func (p Prover) ProveUpgradedConsState(cdc codec.BinaryCodec, upgradedConsState exported.ConsensusState, lastHeight clienttypes.Height) ([]byte, error) {
+	return p.clientState.UpgradedConsStateProof(cdc, upgradedConsState, lastHeight)
+}
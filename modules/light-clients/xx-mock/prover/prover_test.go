@@ -0,0 +1,187 @@
+package prover
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/datachainlab/ibc-mock-client/modules/light-clients/xx-mock/types"
+	"github.com/stretchr/testify/require"
+)
+
+// proofCase is a proof built by a builder of the prover, together with the ICS-24 path and the value it proves.
+// A nil value means that the proof proves the absence of the path.
+type proofCase struct {
+	name  string
+	path  string
+	value []byte
+	prove func(p *Prover, height clienttypes.Height) ([]byte, error)
+}
+
+func newProofCases(cdc codec.BinaryCodec, prefix exported.Prefix) []proofCase {
+	clientState := types.NewClientState(clienttypes.NewHeight(0, 1))
+	consensusState := &types.ConsensusState{Timestamp: 1}
+	consensusHeight := clienttypes.NewHeight(0, 1)
+	connection := connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN, "07-tendermint-0",
+		connectiontypes.NewCounterparty("07-tendermint-1", "connection-1", commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()), 0,
+	)
+	channel := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty("transfer", "channel-1"), []string{"connection-0"}, "ics20-1")
+	packet := channeltypes.NewPacket([]byte("data"), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0)
+	ack := []byte("ack")
+
+	return []proofCase{
+		{
+			"client state", host.FullClientStatePath("07-tendermint-0"), clienttypes.MustMarshalClientState(cdc, clientState),
+			func(p *Prover, height clienttypes.Height) ([]byte, error) {
+				return p.ProveClientState(cdc, prefix, "07-tendermint-0", clientState, height)
+			},
+		},
+		{
+			"consensus state", host.FullConsensusStatePath("07-tendermint-0", consensusHeight), clienttypes.MustMarshalConsensusState(cdc, consensusState),
+			func(p *Prover, height clienttypes.Height) ([]byte, error) {
+				return p.ProveConsensusState(cdc, prefix, "07-tendermint-0", consensusHeight, consensusState, height)
+			},
+		},
+		{
+			"connection", host.ConnectionPath("connection-0"), cdc.MustMarshal(&connection),
+			func(p *Prover, height clienttypes.Height) ([]byte, error) {
+				return p.ProveConnection(cdc, prefix, "connection-0", connection, height)
+			},
+		},
+		{
+			"channel", host.ChannelPath("transfer", "channel-0"), cdc.MustMarshal(&channel),
+			func(p *Prover, height clienttypes.Height) ([]byte, error) {
+				return p.ProveChannel(cdc, prefix, "transfer", "channel-0", channel, height)
+			},
+		},
+		{
+			"packet commitment", host.PacketCommitmentPath("transfer", "channel-0", 1), channeltypes.CommitPacket(cdc, packet),
+			func(p *Prover, height clienttypes.Height) ([]byte, error) {
+				return p.ProvePacketCommitment(cdc, prefix, packet, height)
+			},
+		},
+		{
+			"packet acknowledgement", host.PacketAcknowledgementPath("transfer", "channel-1", 1), channeltypes.CommitAcknowledgement(ack),
+			func(p *Prover, height clienttypes.Height) ([]byte, error) {
+				return p.ProvePacketAcknowledgement(prefix, "transfer", "channel-1", 1, ack, height)
+			},
+		},
+		{
+			"packet receipt absence", host.PacketReceiptPath("transfer", "channel-1", 1), nil,
+			func(p *Prover, height clienttypes.Height) ([]byte, error) {
+				return p.ProvePacketReceiptAbsence(prefix, "transfer", "channel-1", 1, height)
+			},
+		},
+		{
+			"next sequence recv", host.NextSequenceRecvPath("transfer", "channel-1"), sdk.Uint64ToBigEndian(2),
+			func(p *Prover, height clienttypes.Height) ([]byte, error) {
+				return p.ProveNextSequenceRecv(prefix, "transfer", "channel-1", 2, height)
+			},
+		},
+	}
+}
+
+// proofMode configures the client state and the way the proofs are built from the proof cases.
+type proofMode struct {
+	name        string
+	clientState func(cs *types.ClientState)
+	prove       func(p *Prover, prefix exported.Prefix, tc proofCase, height clienttypes.Height) ([]byte, error)
+}
+
+// proveWithBuilder builds the proof with the builder of the proof case.
+func proveWithBuilder(p *Prover, _ exported.Prefix, tc proofCase, height clienttypes.Height) ([]byte, error) {
+	return tc.prove(p, height)
+}
+
+// proveExtended builds the proof of the path and the value of the proof case in the extended encoding.
+func proveExtended(p *Prover, prefix exported.Prefix, tc proofCase, height clienttypes.Height) ([]byte, error) {
+	if tc.value == nil {
+		return p.ProveNonMembershipExtended(prefix, tc.path, height)
+	}
+	return p.ProveMembershipExtended(prefix, tc.path, tc.value, height)
+}
+
+var proofModes = []proofMode{
+	{"default", func(*types.ClientState) {}, proveWithBuilder},
+	{"keccak256", func(cs *types.ClientState) { cs.HashAlgorithm = types.HashAlgorithmKeccak256 }, proveWithBuilder},
+	{"extended", func(cs *types.ClientState) { cs.CommittedNonMembership = true }, proveExtended},
+}
+
+func TestProverRoundTrip(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	prefix := commitmenttypes.NewMerklePrefix([]byte("ibc"))
+	height := clienttypes.NewHeight(0, 10)
+
+	for _, mode := range proofModes {
+		for _, tc := range newProofCases(cdc, prefix) {
+			mode, tc := mode, tc
+			t.Run(mode.name+"/"+tc.name, func(t *testing.T) {
+				key := storetypes.NewKVStoreKey(types.ModuleName)
+				ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+				clientStore := ctx.KVStore(key)
+
+				cs := types.NewClientState(height)
+				mode.clientState(cs)
+				require.NoError(t, cs.Initialize(ctx, cdc, clientStore, &types.ConsensusState{Timestamp: 1}))
+
+				proof, err := mode.prove(NewProver(cs), prefix, tc, height)
+				require.NoError(t, err)
+
+				path, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(tc.path))
+				require.NoError(t, err)
+				if tc.value == nil {
+					require.NoError(t, cs.VerifyNonMembership(ctx, clientStore, cdc, height, 0, 0, proof, path))
+					return
+				}
+				require.NoError(t, cs.VerifyMembership(ctx, clientStore, cdc, height, 0, 0, proof, path, tc.value))
+				require.Error(t, cs.VerifyMembership(ctx, clientStore, cdc, height, 0, 0, proof, path, []byte("other value")))
+			})
+		}
+	}
+}
+
+func TestProveUpgrade(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	for _, mode := range proofModes {
+		mode := mode
+		t.Run(mode.name, func(t *testing.T) {
+			key := storetypes.NewKVStoreKey(types.ModuleName)
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+			clientStore := ctx.KVStore(key)
+
+			cs := types.NewClientState(clienttypes.NewHeight(0, 10))
+			cs.UpgradePath = []string{"upgrade", "upgradedIBCState"}
+			mode.clientState(cs)
+			require.NoError(t, cs.Initialize(ctx, cdc, clientStore, &types.ConsensusState{Timestamp: 1}))
+
+			upgradedClient := types.NewClientState(clienttypes.NewHeight(1, 1))
+			upgradedClient.UpgradePath = cs.UpgradePath
+			mode.clientState(upgradedClient)
+			upgradedConsState := &types.ConsensusState{Timestamp: 2}
+
+			p := NewProver(cs)
+			proofUpgradedClient, err := p.ProveUpgradedClient(cdc, upgradedClient, cs.LatestHeight)
+			require.NoError(t, err)
+			proofUpgradedConsState, err := p.ProveUpgradedConsState(cdc, upgradedConsState, cs.LatestHeight)
+			require.NoError(t, err)
+
+			require.NoError(t, cs.VerifyUpgradeAndUpdateState(ctx, cdc, clientStore, upgradedClient, upgradedConsState, proofUpgradedClient, proofUpgradedConsState))
+		})
+	}
+}
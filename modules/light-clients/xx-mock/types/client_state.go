@@ -1,15 +1,14 @@
 package types
 
 import (
-	"bytes"
-	"crypto/sha256"
-	"encoding/binary"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
-	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
@@ -53,22 +52,138 @@ func (cs ClientState) GetTimestampAtHeight(
 }
 
 // Status returns the status of the mock client.
-// It always returns active.
-func (cs ClientState) Status(_ sdk.Context, _ sdk.KVStore, _ codec.BinaryCodec) exported.Status {
+// The client may be:
+// - Active: FrozenHeight is zero and client is not expired
+// - Frozen: FrozenHeight is not zero
+// - Expired: the latest consensus state timestamp + trusting period <= current time
+//
+// A client with zero trusting period never expires.
+// Unless the client is frozen, the status is overridden by the status schedule of the client
+// once the trigger of a scheduled transition is reached.
+func (cs ClientState) Status(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+) exported.Status {
+	if !cs.FrozenHeight.IsZero() {
+		return exported.Frozen
+	}
+
+	if status, ok := cs.scheduledStatus(ctx); ok {
+		return status
+	}
+
+	if cs.TrustingPeriod == 0 {
+		return exported.Active
+	}
+
+	// get latest consensus state from clientStore to check for expiry
+	consState, found := getConsensusState(clientStore, cdc, cs.GetLatestHeight())
+	if !found {
+		// if the client state does not have an associated consensus state for its latest height
+		// then it must be expired
+		return exported.Expired
+	}
+
+	if cs.IsExpired(consState.Timestamp, ctx.BlockTime()) {
+		return exported.Expired
+	}
+
 	return exported.Active
 }
 
+// IsExpired returns whether or not the client has passed the trusting period since the given timestamp of the latest consensus state.
+func (cs ClientState) IsExpired(latestTimestamp uint64, now time.Time) bool {
+	if cs.TrustingPeriod == 0 {
+		return false
+	}
+	expirationTime := time.Unix(0, int64(latestTimestamp)).Add(cs.TrustingPeriod)
+	return !expirationTime.After(now)
+}
+
 // Validate performs a basic validation of the client state fields.
 func (cs ClientState) Validate() error {
+	if cs.TrustingPeriod < 0 {
+		return sdkerrors.Wrapf(ErrInvalidTrustingPeriod, "trusting period must be non-negative, got %s", cs.TrustingPeriod)
+	}
+	if cs.MaxClockDrift < 0 {
+		return sdkerrors.Wrapf(ErrInvalidMaxClockDrift, "max clock drift must be non-negative, got %s", cs.MaxClockDrift)
+	}
+	if err := cs.HashAlgorithm.Validate(); err != nil {
+		return err
+	}
+	if err := cs.CommitmentScheme.Validate(); err != nil {
+		return err
+	}
+	if cs.PublicKey != nil {
+		if _, err := cs.GetPubKey(); err != nil {
+			return err
+		}
+	}
+	pathKinds := make(map[PathKind]bool, len(cs.PathPolicies))
+	for _, p := range cs.PathPolicies {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		if pathKinds[p.PathKind] {
+			return sdkerrors.Wrapf(ErrInvalidPathPolicy, "duplicate policy for path kind %s", p.PathKind)
+		}
+		pathKinds[p.PathKind] = true
+	}
+	for i, f := range cs.Faults {
+		if err := f.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "fault at index %d", i)
+		}
+	}
+	for i, t := range cs.StatusSchedule {
+		if err := t.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "status transition at index %d", i)
+		}
+	}
+	if err := cs.PruningPolicy.Validate(); err != nil {
+		return err
+	}
+	for i, k := range cs.UpgradePath {
+		if strings.TrimSpace(k) == "" {
+			return sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "key in upgrade path at index %d cannot be empty", i)
+		}
+	}
 	return nil
 }
 
+// GetPubKey returns the public key of the authority which signs the headers.
+// A nil public key is returned if the client accepts unsigned headers.
+func (cs ClientState) GetPubKey() (cryptotypes.PubKey, error) {
+	if cs.PublicKey == nil {
+		return nil, nil
+	}
+	return unpackAuthorityPubKey(cs.PublicKey)
+}
+
 // ZeroCustomFields returns a ClientState that is a copy of the current ClientState
-// with all client customizable fields zeroed out (but Mock ClientState has no such field)
+// with all client customizable fields zeroed out
 func (cs ClientState) ZeroCustomFields() exported.ClientState {
-	return &ClientState{
-		LatestHeight: cs.LatestHeight,
-	}
+	cs.FrozenHeight = clienttypes.ZeroHeight()
+	zeroClientParams(&cs)
+	return &cs
+}
+
+// copyClientParams copies the client-chosen parameters from src to dst.
+// The client-chosen parameters are the fields zeroed out by ZeroCustomFields other than the frozen height,
+// which are kept from the current client on upgrade and taken from the substitute on substitution.
+// All the other fields are chain-chosen parameters, except for the latest and frozen heights.
+func copyClientParams(dst *ClientState, src ClientState) {
+	dst.TrustingPeriod = src.TrustingPeriod
+	dst.MaxClockDrift = src.MaxClockDrift
+	dst.PathPolicies = src.PathPolicies
+	dst.Faults = src.Faults
+	dst.StatusSchedule = src.StatusSchedule
+	dst.PruningPolicy = src.PruningPolicy
+}
+
+// zeroClientParams zeroes out the client-chosen parameters of the client state.
+func zeroClientParams(cs *ClientState) {
+	copyClientParams(cs, ClientState{})
 }
 
 // Initialize will check that initial consensus state is equal to the latest consensus state of the initial client.
@@ -78,6 +193,9 @@ func (cs ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientS
 		return sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "invalid initial consensus state. expected type: %T, got: %T",
 			&ConsensusState{}, consState)
 	}
+	if cs.CommitmentScheme == CommitmentSchemeICS23 && len(consensusState.Root) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "initial consensus state must have a commitment root for the ICS-23 commitment scheme")
+	}
 
 	setClientState(clientStore, cdc, &cs)
 	setConsensusState(clientStore, cdc, consensusState, cs.GetLatestHeight())
@@ -89,6 +207,7 @@ func (cs ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientS
 
 // VerifyMembership is a generic proof verification method which verifies a proof of the existence of a value at a given CommitmentPath at the specified height.
 // The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+// The proof is accepted or rejected without verification if the policy for the kind of the path says so.
 func (cs ClientState) VerifyMembership(
 	ctx sdk.Context,
 	clientStore sdk.KVStore,
@@ -100,6 +219,10 @@ func (cs ClientState) VerifyMembership(
 	path exported.Path,
 	value []byte,
 ) error {
+	if err := cs.checkProofFault(FaultTargetMembership, height, path); err != nil {
+		return err
+	}
+
 	if cs.GetLatestHeight().LT(height) {
 		return sdkerrors.Wrapf(
 			sdkerrors.ErrInvalidHeight,
@@ -111,48 +234,21 @@ func (cs ClientState) VerifyMembership(
 		return err
 	}
 
-	if _, found := getConsensusState(clientStore, cdc, height); !found {
+	consensusState, found := getConsensusState(clientStore, cdc, height)
+	if !found {
 		return sdkerrors.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
 	}
 
-	// sha256(abi.encodePacked(height.toUint128(), sha256(prefix), sha256(path), sha256(value)))
-	revisionNumber := height.GetRevisionNumber()
-	revisionHeight := height.GetRevisionHeight()
-
-	heightBuf := make([]byte, 16)
-	binary.BigEndian.PutUint64(heightBuf[:8], revisionNumber)
-	binary.BigEndian.PutUint64(heightBuf[8:], revisionHeight)
-
-	merklePath := path.(commitmenttypes.MerklePath)
-	mPrefix, err := merklePath.GetKey(0)
-	if err != nil {
-		return sdkerrors.Wrapf(err, "invalid merkle path key at index 0")
-	}
-	mPath, err := merklePath.GetKey(1)
-	if err != nil {
-		return sdkerrors.Wrapf(err, "invalid merkle path key at index 1")
-	}
-
-	hashPrefix := sha256.Sum256([]byte(mPrefix))
-	hashPath := sha256.Sum256([]byte(mPath))
-	hashValue := sha256.Sum256([]byte(value))
-
-	var combined []byte
-	combined = append(combined, heightBuf...)
-	combined = append(combined, hashPrefix[:]...)
-	combined = append(combined, hashPath[:]...)
-	combined = append(combined, hashValue[:]...)
-	h := sha256.Sum256(combined)
-
-	if !bytes.Equal(proof, h[:]) {
-		return sdkerrors.Wrapf(ErrInvalidProof, "expected the proof '%X', actually got '%X'", h, proof)
+	if verify, err := cs.checkVerificationPolicy(path); !verify {
+		return err
 	}
 
-	return nil
+	return cs.verifyMembershipProof(cdc, consensusState, height, proof, path, value)
 }
 
 // VerifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath at a specified height.
 // The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+// The proof is accepted or rejected without verification if the policy for the kind of the path says so.
 func (cs ClientState) VerifyNonMembership(
 	ctx sdk.Context,
 	clientStore sdk.KVStore,
@@ -163,6 +259,10 @@ func (cs ClientState) VerifyNonMembership(
 	proof []byte,
 	path exported.Path,
 ) error {
+	if err := cs.checkProofFault(FaultTargetNonMembership, height, path); err != nil {
+		return err
+	}
+
 	if cs.GetLatestHeight().LT(height) {
 		return sdkerrors.Wrapf(
 			sdkerrors.ErrInvalidHeight,
@@ -174,23 +274,16 @@ func (cs ClientState) VerifyNonMembership(
 		return err
 	}
 
-	if _, found := getConsensusState(clientStore, cdc, height); !found {
+	consensusState, found := getConsensusState(clientStore, cdc, height)
+	if !found {
 		return sdkerrors.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
 	}
 
-	if len(proof) != 0 {
-		return sdkerrors.Wrapf(ErrInvalidProof, "expected the empty proof, actually got '%X'", proof)
+	if verify, err := cs.checkVerificationPolicy(path); !verify {
+		return err
 	}
 
-	return nil
-}
-
-// VerifyUpgradeAndUpdateState returns an error since Mock client does not support upgrades
-func (cs ClientState) VerifyUpgradeAndUpdateState(
-	_ sdk.Context, _ codec.BinaryCodec, _ sdk.KVStore,
-	_ exported.ClientState, _ exported.ConsensusState, _, _ []byte,
-) error {
-	return sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade Mock client")
+	return cs.verifyNonMembershipProof(cdc, consensusState, height, proof, path)
 }
 
 // verifyDelayPeriodPassed will ensure that at least delayTimePeriod amount of time and delayBlockPeriod number of blocks have passed
@@ -216,7 +309,10 @@ func verifyDelayPeriodPassed(ctx sdk.Context, store sdk.KVStore, proofHeight exp
 
 	if delayBlockPeriod != 0 {
 		// check that executing chain's height has passed consensusState's processed height + delay block period
-		processedHeight, ok := getProcessedHeight(store, proofHeight)
+		processedHeight, ok, err := getProcessedHeight(store, proofHeight)
+		if err != nil {
+			return err
+		}
 		if !ok {
 			return sdkerrors.Wrapf(ErrProcessedHeightNotFound, "processed height not found for height: %s", proofHeight)
 		}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+// chainChosenFields are the fields of ClientState which are chosen by the counterparty chain,
+// and thus kept by ZeroCustomFields and committed for upgrades.
+var chainChosenFields = map[string]bool{
+	"LatestHeight":           true,
+	"UpgradePath":            true,
+	"HashAlgorithm":          true,
+	"PublicKey":              true,
+	"CommitmentScheme":       true,
+	"CommittedNonMembership": true,
+}
+
+func TestClientParams(t *testing.T) {
+	cs := ClientState{
+		LatestHeight:           clienttypes.NewHeight(0, 10),
+		FrozenHeight:           FrozenHeight,
+		TrustingPeriod:         time.Hour,
+		UpgradePath:            []string{"upgrade", "upgradedIBCState"},
+		HashAlgorithm:          HashAlgorithmKeccak256,
+		PublicKey:              newPubKeyAny(t, ed25519.GenPrivKey().PubKey()),
+		CommitmentScheme:       CommitmentSchemeICS23,
+		CommittedNonMembership: true,
+		PathPolicies:           []PathPolicy{{PathKind: PathKindConnection, Policy: VerificationPolicyAlwaysAccept}},
+		Faults:                 []Fault{{Target: FaultTargetMembership}},
+		StatusSchedule:         []StatusTransition{{Status: ScheduledStatusFrozen, SelfHeight: clienttypes.NewHeight(0, 1)}},
+		MaxClockDrift:          time.Minute,
+		PruningPolicy:          PruningPolicy{KeepLast: 1},
+	}
+
+	fields := reflect.TypeOf(cs)
+	for i := 0; i < fields.NumField(); i++ {
+		require.False(t, reflect.ValueOf(cs).Field(i).IsZero(), "field %s must be set in this test", fields.Field(i).Name)
+	}
+
+	zeroed := *cs.ZeroCustomFields().(*ClientState)
+	var copied ClientState
+	copyClientParams(&copied, cs)
+	zeroedParams := cs
+	zeroClientParams(&zeroedParams)
+
+	for i := 0; i < fields.NumField(); i++ {
+		name := fields.Field(i).Name
+		value := reflect.ValueOf(cs).Field(i).Interface()
+		switch {
+		case name == "FrozenHeight":
+			require.True(t, reflect.ValueOf(zeroed).Field(i).IsZero(), name)
+			require.True(t, reflect.ValueOf(copied).Field(i).IsZero(), name)
+			require.Equal(t, value, reflect.ValueOf(zeroedParams).Field(i).Interface(), name)
+		case chainChosenFields[name]:
+			require.Equal(t, value, reflect.ValueOf(zeroed).Field(i).Interface(), name)
+			require.True(t, reflect.ValueOf(copied).Field(i).IsZero(), "chain-chosen field %s must not be copied by copyClientParams", name)
+			require.Equal(t, value, reflect.ValueOf(zeroedParams).Field(i).Interface(), name)
+		default:
+			require.True(t, reflect.ValueOf(zeroed).Field(i).IsZero(), "field %s must be zeroed out by ZeroCustomFields", name)
+			require.Equal(t, value, reflect.ValueOf(copied).Field(i).Interface(), "field %s must be copied by copyClientParams", name)
+			require.True(t, reflect.ValueOf(zeroedParams).Field(i).IsZero(), "field %s must be zeroed out by zeroClientParams", name)
+		}
+	}
+
+	// client states differing only in their client-chosen parameters and heights match
+	require.True(t, IsMatchingClientState(cs, zeroed))
+	other := cs
+	other.CommittedNonMembership = false
+	require.False(t, IsMatchingClientState(cs, other))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
@@ -12,6 +13,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*exported.ClientMessage)(nil),
 		&Header{},
 	)
+	registry.RegisterImplementations(
+		(*exported.ClientMessage)(nil),
+		&Misbehaviour{},
+	)
 	registry.RegisterImplementations(
 		(*exported.ClientState)(nil),
 		&ClientState{},
@@ -23,14 +28,45 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 }
 
 // Interface implementation checks.
-var _, _ codectypes.UnpackInterfacesMessage = &ClientState{}, &ConsensusState{}
+var (
+	_ codectypes.UnpackInterfacesMessage = &ClientState{}
+	_ codectypes.UnpackInterfacesMessage = &ConsensusState{}
+	_ codectypes.UnpackInterfacesMessage = &Header{}
+	_ codectypes.UnpackInterfacesMessage = &Misbehaviour{}
+)
 
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
 func (cs ClientState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	return nil
+	if cs.PublicKey == nil {
+		return nil
+	}
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(cs.PublicKey, &pubKey)
 }
 
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
 func (cs ConsensusState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return nil
 }
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (h Header) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if h.NewPublicKey == nil {
+		return nil
+	}
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(h.NewPublicKey, &pubKey)
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (misbehaviour Misbehaviour) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if misbehaviour.Header1 != nil {
+		if err := misbehaviour.Header1.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	if misbehaviour.Header2 != nil {
+		return misbehaviour.Header2.UnpackInterfaces(unpacker)
+	}
+	return nil
+}
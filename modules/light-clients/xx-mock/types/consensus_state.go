@@ -1,8 +1,11 @@
 package types
 
 import (
+	"bytes"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
@@ -18,9 +21,15 @@ func (cs ConsensusState) GetTimestamp() uint64 {
 	return cs.Timestamp
 }
 
-// GetRoot returns nil since mock-client do not have roots.
+// GetRoot returns the commitment root of the consensus state, which is used by the ICS-23 commitment scheme.
 func (cs ConsensusState) GetRoot() exported.Root {
-	return nil
+	return commitmenttypes.NewMerkleRoot(cs.Root)
+}
+
+// IsEqual returns whether the consensus states have the same timestamp and commitment root.
+// A nil root is equal to an empty root.
+func (cs ConsensusState) IsEqual(other ConsensusState) bool {
+	return cs.Timestamp == other.Timestamp && bytes.Equal(cs.Root, other.Root)
 }
 
 // ValidateBasic defines basic validation for the mock-client consensus state.
@@ -0,0 +1,155 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/gogoproto/proto"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// diffValues returns a description of the differences between the expected value and the actual value
+// stored at the path. The values are decoded according to the kind of the path and compared field by field;
+// values which cannot be decoded are compared as bytes.
+func diffValues(cdc codec.BinaryCodec, path exported.Path, expected, actual []byte) string {
+	kind := ClassifyPath(path)
+
+	expectedJSON, err := decodeValueJSON(cdc, kind, expected)
+	if err != nil {
+		return fmt.Sprintf("%s value: failed to decode the expected value '%X': %v", kind, expected, err)
+	}
+	actualJSON, err := decodeValueJSON(cdc, kind, actual)
+	if err != nil {
+		return fmt.Sprintf("%s value: failed to decode the actual value '%X': %v", kind, actual, err)
+	}
+
+	diffs := diffJSON("", expectedJSON, actualJSON)
+	if len(diffs) == 0 {
+		return fmt.Sprintf("%s value: the decoded values are equal but their encodings differ: expected '%X', actually got '%X'", kind, expected, actual)
+	}
+	return fmt.Sprintf("%s value diff:\n%s", kind, strings.Join(diffs, "\n"))
+}
+
+// decodeValueJSON decodes the value of the given kind and returns its JSON representation as a generic value.
+// Values whose kind has no structure are represented as hex strings.
+func decodeValueJSON(cdc codec.BinaryCodec, kind PathKind, bz []byte) (interface{}, error) {
+	var msg proto.Message
+	switch kind {
+	case PathKindConnection:
+		var connection connectiontypes.ConnectionEnd
+		if err := cdc.Unmarshal(bz, &connection); err != nil {
+			return nil, err
+		}
+		msg = &connection
+	case PathKindChannel:
+		var channel channeltypes.Channel
+		if err := cdc.Unmarshal(bz, &channel); err != nil {
+			return nil, err
+		}
+		msg = &channel
+	case PathKindClientState:
+		clientState, err := clienttypes.UnmarshalClientState(cdc, bz)
+		if err != nil {
+			return nil, err
+		}
+		msg = clientState
+	case PathKindConsensusState:
+		consensusState, err := clienttypes.UnmarshalConsensusState(cdc, bz)
+		if err != nil {
+			return nil, err
+		}
+		msg = consensusState
+	default:
+		return fmt.Sprintf("%X", bz), nil
+	}
+
+	var (
+		bzJSON []byte
+		err    error
+	)
+	if jsonCdc, ok := cdc.(codec.JSONCodec); ok {
+		bzJSON, err = jsonCdc.MarshalJSON(msg)
+	} else {
+		bzJSON, err = codec.ProtoMarshalJSON(msg, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(bzJSON, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// diffJSON returns a line for each field of the generic JSON values which differs, prefixed with its field path.
+func diffJSON(field string, expected, actual interface{}) []string {
+	expectedObject, expectedIsObject := expected.(map[string]interface{})
+	actualObject, actualIsObject := actual.(map[string]interface{})
+	if expectedIsObject && actualIsObject {
+		keys := make(map[string]struct{})
+		for k := range expectedObject {
+			keys[k] = struct{}{}
+		}
+		for k := range actualObject {
+			keys[k] = struct{}{}
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for k := range keys {
+			sortedKeys = append(sortedKeys, k)
+		}
+		sort.Strings(sortedKeys)
+
+		var diffs []string
+		for _, k := range sortedKeys {
+			diffs = append(diffs, diffJSON(joinField(field, k), expectedObject[k], actualObject[k])...)
+		}
+		return diffs
+	}
+
+	expectedArray, expectedIsArray := expected.([]interface{})
+	actualArray, actualIsArray := actual.([]interface{})
+	if expectedIsArray && actualIsArray && len(expectedArray) == len(actualArray) {
+		var diffs []string
+		for i := range expectedArray {
+			diffs = append(diffs, diffJSON(fmt.Sprintf("%s[%d]", field, i), expectedArray[i], actualArray[i])...)
+		}
+		return diffs
+	}
+
+	if reflect.DeepEqual(expected, actual) {
+		return nil
+	}
+	if field == "" {
+		field = "value"
+	}
+	return []string{fmt.Sprintf("  %s: expected %s, actually got %s", field, formatJSON(expected), formatJSON(actual))}
+}
+
+// joinField joins the field path and the name of a nested field.
+func joinField(field, name string) string {
+	if field == "" {
+		return name
+	}
+	return field + "." + name
+}
+
+// formatJSON formats the generic JSON value for a diff line.
+func formatJSON(value interface{}) string {
+	if value == nil {
+		return "<none>"
+	}
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(bz)
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyMembershipProofReportsValueDiff(t *testing.T) {
+	_, _, cdc := newTestContext(t, time.Unix(1, 0))
+	cs := NewClientState(clienttypes.NewHeight(0, 5))
+	height := clienttypes.NewHeight(0, 5)
+	consensusState := &ConsensusState{Timestamp: 1}
+
+	newConnection := func(clientID string) []byte {
+		connection := connectiontypes.NewConnectionEnd(
+			connectiontypes.INIT, clientID,
+			connectiontypes.NewCounterparty("07-tendermint-1", "", commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+			nil, 0,
+		)
+		return cdc.MustMarshal(&connection)
+	}
+	path := commitmenttypes.NewMerklePath("ibc", "connections/connection-0")
+	proof, err := cs.PreimageMembershipProof(height, path, newConnection("07-tendermint-0"))
+	require.NoError(t, err)
+
+	err = cs.verifyMembershipProof(cdc, consensusState, height, proof, path, newConnection("07-tendermint-2"))
+	require.ErrorIs(t, err, ErrProofValueMismatch)
+	require.Contains(t, err.Error(), "value diff:\n  client_id: expected \"07-tendermint-2\", actually got \"07-tendermint-0\"")
+
+	// values without structure are compared as bytes
+	path = commitmenttypes.NewMerklePath("ibc", "commitments/ports/transfer/channels/channel-0/sequences/1")
+	proof, err = cs.PreimageMembershipProof(height, path, []byte{0xab})
+	require.NoError(t, err)
+
+	err = cs.verifyMembershipProof(cdc, consensusState, height, proof, path, []byte{0xcd})
+	require.ErrorIs(t, err, ErrProofValueMismatch)
+	require.Contains(t, err.Error(), "value diff:\n  value: expected \"CD\", actually got \"AB\"")
+
+	// the diff is not reported without the value in the proof
+	proof, err = cs.ExtendedMembershipProof(height, path, []byte{0xab})
+	require.NoError(t, err)
+	err = cs.verifyMembershipProof(cdc, consensusState, height, proof, path, []byte{0xcd})
+	require.ErrorIs(t, err, ErrProofValueMismatch)
+	require.NotContains(t, err.Error(), "diff")
+}
@@ -14,4 +14,25 @@ var (
 	ErrProcessedTimeNotFound   = sdkerrors.Register(ModuleName, 8, "processed time not found")
 	ErrProcessedHeightNotFound = sdkerrors.Register(ModuleName, 9, "processed height not found")
 	ErrDelayPeriodNotPassed    = sdkerrors.Register(ModuleName, 10, "packet-specified delay period has not been reached")
+	ErrInvalidTrustingPeriod   = sdkerrors.Register(ModuleName, 11, "invalid trusting period")
+	ErrInvalidHashAlgorithm    = sdkerrors.Register(ModuleName, 12, "invalid hash algorithm")
+	ErrInvalidPublicKey        = sdkerrors.Register(ModuleName, 13, "invalid authority public key")
+	ErrInvalidSignature        = sdkerrors.Register(ModuleName, 14, "invalid header signature")
+	ErrInsufficientSignatures  = sdkerrors.Register(ModuleName, 15, "insufficient header signatures")
+	ErrInvalidCommitmentScheme = sdkerrors.Register(ModuleName, 16, "invalid commitment scheme")
+	ErrProofHeightMismatch     = sdkerrors.Register(ModuleName, 17, "proof height mismatch")
+	ErrProofPrefixMismatch     = sdkerrors.Register(ModuleName, 18, "proof prefix mismatch")
+	ErrProofPathMismatch       = sdkerrors.Register(ModuleName, 19, "proof path mismatch")
+	ErrProofValueMismatch      = sdkerrors.Register(ModuleName, 20, "proof value mismatch")
+	ErrInvalidPathPolicy       = sdkerrors.Register(ModuleName, 21, "invalid path policy")
+	ErrRejectedByPolicy        = sdkerrors.Register(ModuleName, 22, "proof rejected by verification policy")
+	ErrInvalidFault            = sdkerrors.Register(ModuleName, 23, "invalid fault")
+	ErrInvalidStatusSchedule   = sdkerrors.Register(ModuleName, 24, "invalid status schedule")
+	ErrZeroHeaderTimestamp     = sdkerrors.Register(ModuleName, 25, "header timestamp cannot be zero")
+	ErrNonMonotonicTimestamp   = sdkerrors.Register(ModuleName, 26, "header timestamp is not between the timestamps of the neighbouring consensus states")
+	ErrMaxClockDriftExceeded   = sdkerrors.Register(ModuleName, 27, "header timestamp exceeds the max clock drift")
+	ErrInvalidMaxClockDrift    = sdkerrors.Register(ModuleName, 28, "invalid max clock drift")
+	ErrInvalidIterationKey     = sdkerrors.Register(ModuleName, 29, "invalid iteration key")
+	ErrInvalidPruningPolicy    = sdkerrors.Register(ModuleName, 30, "invalid pruning policy")
+	ErrInvalidProcessedHeight  = sdkerrors.Register(ModuleName, 31, "invalid processed height")
 )
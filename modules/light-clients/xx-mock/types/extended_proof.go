@@ -0,0 +1,51 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Commitment returns the flat proof of the components computed with the given hash algorithm:
+// H(abi.encodePacked(height.toUint128(), keyHashes..., valueHash))
+func (p ExtendedProof) Commitment(hashAlgorithm HashAlgorithm) ([]byte, error) {
+	heightBuf := make([]byte, 16)
+	binary.BigEndian.PutUint64(heightBuf[:8], p.Height.RevisionNumber)
+	binary.BigEndian.PutUint64(heightBuf[8:], p.Height.RevisionHeight)
+
+	data := make([][]byte, 0, len(p.KeyHashes)+2)
+	data = append(data, heightBuf)
+	data = append(data, p.KeyHashes...)
+	data = append(data, p.ValueHash)
+
+	return hashAlgorithm.Hash(data...)
+}
+
+// Compare returns an error describing the first component of the actual proof which does not match the expected proof,
+// in the order of the height, the prefix, the path and the value.
+func (p ExtendedProof) Compare(actual ExtendedProof) error {
+	if !p.Height.EQ(actual.Height) {
+		return sdkerrors.Wrapf(ErrProofHeightMismatch, "expected the height %s, actually got %s", p.Height, actual.Height)
+	}
+
+	if len(actual.KeyHashes) == 0 {
+		return sdkerrors.Wrap(ErrProofPrefixMismatch, "the proof has no prefix hash")
+	}
+	if !bytes.Equal(p.KeyHashes[0], actual.KeyHashes[0]) {
+		return sdkerrors.Wrapf(ErrProofPrefixMismatch, "expected the prefix hash '%X', actually got '%X'", p.KeyHashes[0], actual.KeyHashes[0])
+	}
+	if len(p.KeyHashes) != len(actual.KeyHashes) {
+		return sdkerrors.Wrapf(ErrProofPathMismatch, "expected %d path keys, actually got %d", len(p.KeyHashes)-1, len(actual.KeyHashes)-1)
+	}
+	for i := 1; i < len(p.KeyHashes); i++ {
+		if !bytes.Equal(p.KeyHashes[i], actual.KeyHashes[i]) {
+			return sdkerrors.Wrapf(ErrProofPathMismatch, "expected the hash of the path key %d '%X', actually got '%X'", i, p.KeyHashes[i], actual.KeyHashes[i])
+		}
+	}
+
+	if !bytes.Equal(p.ValueHash, actual.ValueHash) {
+		return sdkerrors.Wrapf(ErrProofValueMismatch, "expected the value hash '%X', actually got '%X'", p.ValueHash, actual.ValueHash)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// Validate returns an error if the fault is malformed.
+func (f Fault) Validate() error {
+	if _, ok := FaultTarget_name[int32(f.Target)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidFault, "unknown fault target: %d", f.Target)
+	}
+	if !f.MinHeight.IsZero() && !f.MaxHeight.IsZero() && f.MaxHeight.LT(f.MinHeight) {
+		return sdkerrors.Wrapf(ErrInvalidFault, "max height %s must not be less than min height %s", f.MaxHeight, f.MinHeight)
+	}
+	for _, kind := range f.PathKinds {
+		if _, ok := PathKind_name[int32(kind)]; !ok {
+			return sdkerrors.Wrapf(ErrInvalidFault, "unknown path kind: %d", kind)
+		}
+	}
+	if f.Target == FaultTargetClientMessage && len(f.PathKinds) != 0 {
+		return sdkerrors.Wrap(ErrInvalidFault, "faults of client messages cannot have path kinds")
+	}
+	if strings.TrimSpace(f.Codespace) == "" {
+		return sdkerrors.Wrap(ErrInvalidFault, "codespace cannot be empty")
+	}
+	if f.Code == sdkerrors.SuccessABCICode {
+		return sdkerrors.Wrapf(ErrInvalidFault, "code cannot be %d, which means success", sdkerrors.SuccessABCICode)
+	}
+	if !isRegisteredError(f.Codespace, f.Code) {
+		return sdkerrors.Wrapf(ErrInvalidFault, "no error is registered with codespace %s and code %d", f.Codespace, f.Code)
+	}
+	return nil
+}
+
+// isRegisteredError returns whether an error is registered with the codespace and code.
+// ABCIError returns the registered error itself if any, and otherwise a new unregistered error on each call.
+func isRegisteredError(codespace string, code uint32) bool {
+	var first, second *sdkerrors.Error
+	if !errors.As(sdkerrors.ABCIError(codespace, code, ""), &first) || !errors.As(sdkerrors.ABCIError(codespace, code, ""), &second) {
+		return false
+	}
+	return first == second
+}
+
+// Error returns the registered error of the fault.
+func (f Fault) Error(log string) error {
+	return sdkerrors.ABCIError(f.Codespace, f.Code, log)
+}
+
+// matchHeight returns whether the height is within the height range of the fault.
+func (f Fault) matchHeight(height exported.Height) bool {
+	if !f.MinHeight.IsZero() && height.LT(f.MinHeight) {
+		return false
+	}
+	if !f.MaxHeight.IsZero() && height.GT(f.MaxHeight) {
+		return false
+	}
+	return true
+}
+
+// matchPath returns whether the path is of one of the path kinds of the fault.
+func (f Fault) matchPath(path exported.Path) bool {
+	if len(f.PathKinds) == 0 {
+		return true
+	}
+	kind := ClassifyPath(path)
+	for _, k := range f.PathKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// checkProofFault returns the error of the first fault injected into the given proof verification function
+// for the proof height and path, or nil if there is no such fault.
+func (cs ClientState) checkProofFault(target FaultTarget, height exported.Height, path exported.Path) error {
+	for _, f := range cs.Faults {
+		if (f.Target == FaultTargetAny || f.Target == target) && f.matchHeight(height) && f.matchPath(path) {
+			return f.Error(fmt.Sprintf("injected fault of %s at height %s for path %s", target, height, path))
+		}
+	}
+	return nil
+}
+
+// checkClientMessageFault returns the error of the first fault injected into VerifyClientMessage
+// for the height of the client message, or nil if there is no such fault.
+func (cs ClientState) checkClientMessageFault(clientMsg exported.ClientMessage) error {
+	var height exported.Height
+	switch msg := clientMsg.(type) {
+	case *Header:
+		height = msg.GetHeight()
+	case *Misbehaviour:
+		if msg.Header1 == nil {
+			return nil
+		}
+		height = msg.Header1.GetHeight()
+	default:
+		return nil
+	}
+
+	for _, f := range cs.Faults {
+		if (f.Target == FaultTargetAny || f.Target == FaultTargetClientMessage) && len(f.PathKinds) == 0 && f.matchHeight(height) {
+			return f.Error(fmt.Sprintf("injected fault of %s at height %s", FaultTargetClientMessage, height))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFaultValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		fault   Fault
+		expPass bool
+	}{
+		{"registered mock error", Fault{Codespace: ModuleName, Code: ErrDelayPeriodNotPassed.ABCICode()}, true},
+		{"registered sdk error", Fault{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrUnauthorized.ABCICode()}, true},
+		{"success code", Fault{Codespace: ModuleName, Code: 0}, false},
+		{"unregistered code", Fault{Codespace: ModuleName, Code: 9999}, false},
+		{"unregistered codespace", Fault{Codespace: "unknown-codespace", Code: 1}, false},
+		{"empty codespace", Fault{Code: 1}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.fault.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, ErrInvalidFault, tc.name)
+		}
+	}
+}
+
+func TestFaultError(t *testing.T) {
+	fault := Fault{Codespace: ModuleName, Code: ErrDelayPeriodNotPassed.ABCICode()}
+	require.ErrorIs(t, fault.Error("injected"), ErrDelayPeriodNotPassed)
+}
@@ -1,13 +1,24 @@
 package types
 
 import (
-	fmt "fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
-// ExportMetadata always panics. This function is used only for testing.
-func (cs ClientState) ExportMetadata(_ sdk.KVStore) []exported.GenesisMetadata {
-	panic(fmt.Errorf("not implemented"))
+// ExportMetadata exports all the consensus metadata in the client store so they can be included in clients genesis
+// and imported by a ClientKeeper.
+// The exported entries are the raw key-value pairs written by setConsensusMetadataWithValues and the iteration keys
+// written by setConsensusState, so importing them back into the client store restores the processed time and height
+// and the ordered index of the consensus states as is.
+func (cs ClientState) ExportMetadata(store sdk.KVStore) []exported.GenesisMetadata {
+	gm := make([]exported.GenesisMetadata, 0)
+	IterateConsensusMetadata(store, func(key, val []byte) bool {
+		gm = append(gm, clienttypes.NewGenesisMetadata(key, val))
+		return false
+	})
+	if len(gm) == 0 {
+		return nil
+	}
+	return gm
 }
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportMetadataRoundTrip(t *testing.T) {
+	_, store, cdc := newTestContext(t, time.Unix(0, 1000))
+	exportStore := prefix.NewStore(store, []byte("export/"))
+	importStore := prefix.NewStore(store, []byte("import/"))
+
+	cs := NewClientState(clienttypes.NewHeight(0, 20))
+	heights := []clienttypes.Height{clienttypes.NewHeight(0, 10), clienttypes.NewHeight(0, 20)}
+	for i, height := range heights {
+		setConsensusState(exportStore, cdc, &ConsensusState{Timestamp: uint64(i + 1)}, height)
+		setConsensusMetadataWithValues(exportStore, height, clienttypes.NewHeight(0, uint64(100+i)), uint64(1000+i))
+	}
+
+	metadata := cs.ExportMetadata(exportStore)
+	require.NotEmpty(t, metadata)
+
+	// import the client store as 02-client InitGenesis does
+	for _, height := range heights {
+		importStore.Set(host.ConsensusStateKey(height), exportStore.Get(host.ConsensusStateKey(height)))
+	}
+	for _, m := range metadata {
+		importStore.Set(m.GetKey(), m.GetValue())
+	}
+
+	// the processed times and heights are restored as is
+	require.Equal(t, metadata, cs.ExportMetadata(importStore))
+	for i, height := range heights {
+		processedTime, found := getProcessedTime(importStore, height)
+		require.True(t, found)
+		require.Equal(t, uint64(1000+i), processedTime)
+	}
+}
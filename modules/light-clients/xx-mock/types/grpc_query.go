@@ -0,0 +1,152 @@
+package types
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ QueryServer = Querier{}
+
+// Querier implements the Query gRPC service on top of the stores of Mock clients.
+type Querier struct {
+	cdc          codec.BinaryCodec
+	clientKeeper ClientKeeper
+}
+
+// NewQuerier creates a new Querier instance.
+func NewQuerier(cdc codec.BinaryCodec, clientKeeper ClientKeeper) Querier {
+	return Querier{
+		cdc:          cdc,
+		clientKeeper: clientKeeper,
+	}
+}
+
+// ConsensusStates implements the Query/ConsensusStates gRPC method
+func (q Querier) ConsensusStates(c context.Context, req *QueryConsensusStatesRequest) (*QueryConsensusStatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := q.getClientState(ctx, req.ClientId); err != nil {
+		return nil, err
+	}
+	clientStore := q.clientKeeper.ClientStore(ctx, req.ClientId)
+
+	consensusStates := []ConsensusStateWithMetadata{}
+	pageRes, err := paginateConsensusStates(clientStore, q.cdc, req.Pagination, func(height clienttypes.Height, consensusState *ConsensusState) error {
+		entry, err := newConsensusStateWithMetadata(clientStore, height, consensusState)
+		if err != nil {
+			return err
+		}
+		consensusStates = append(consensusStates, *entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &QueryConsensusStatesResponse{
+		ConsensusStates: consensusStates,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// ConsensusState implements the Query/ConsensusState gRPC method
+func (q Querier) ConsensusState(c context.Context, req *QueryConsensusStateRequest) (*QueryConsensusStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := q.getClientState(ctx, req.ClientId); err != nil {
+		return nil, err
+	}
+	clientStore := q.clientKeeper.ClientStore(ctx, req.ClientId)
+
+	height := clienttypes.NewHeight(req.RevisionNumber, req.RevisionHeight)
+	foundHeight, consensusState, found, err := getConsensusStateAtOrBefore(clientStore, q.cdc, height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "client-id: %s, height: %s", req.ClientId, height).Error(),
+		)
+	}
+
+	entry, err := newConsensusStateWithMetadata(clientStore, foundHeight, consensusState)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &QueryConsensusStateResponse{
+		ConsensusState: *entry,
+	}, nil
+}
+
+// MembershipProof implements the Query/MembershipProof gRPC method
+func (q Querier) MembershipProof(c context.Context, req *QueryMembershipProofRequest) (*QueryMembershipProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	clientState, err := q.getClientState(ctx, req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	merklePath, err := commitmenttypes.ApplyPrefix(commitmenttypes.NewMerklePrefix(req.Prefix), commitmenttypes.NewMerklePath(req.Path))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var proof []byte
+	if req.Extended {
+		proof, err = clientState.ExtendedMembershipProof(req.ProofHeight, merklePath, req.Value)
+	} else {
+		proof, err = clientState.MembershipProof(req.ProofHeight, merklePath, req.Value)
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &QueryMembershipProofResponse{
+		Proof: proof,
+	}, nil
+}
+
+// getClientState returns the client state of the given client if it is a Mock client.
+func (q Querier) getClientState(ctx sdk.Context, clientID string) (*ClientState, error) {
+	clientState, found := q.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID).Error())
+	}
+	mockClientState, ok := clientState.(*ClientState)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "client %s is not a mock client: expected %T, got %T", clientID, &ClientState{}, clientState)
+	}
+	return mockClientState, nil
+}
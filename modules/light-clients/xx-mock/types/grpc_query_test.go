@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestQueryConsensusStatesOfNonMockClient(t *testing.T) {
+	ctx, clientStore, cdc := newTestContext(t, time.Unix(1, 0))
+	clientID := "07-tendermint-0"
+	// a tendermint client uses the same keys for its consensus states and iteration index
+	setConsensusState(clientStore, cdc, &ConsensusState{Timestamp: 1}, clienttypes.NewHeight(0, 1))
+	querier := NewQuerier(cdc, testClientKeeper{clientID: clientID, clientState: &ibctm.ClientState{}, clientStore: clientStore})
+
+	_, err := querier.ConsensusStates(sdk.WrapSDKContext(ctx), &QueryConsensusStatesRequest{ClientId: clientID})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = querier.ConsensusState(sdk.WrapSDKContext(ctx), &QueryConsensusStateRequest{ClientId: clientID, RevisionHeight: 1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryConsensusState(t *testing.T) {
+	ctx, clientStore, cdc := newTestContext(t, time.Unix(1, 0))
+	clientID := Mock + "-0"
+	storeConsensusStates(ctx, clientStore, cdc, map[uint64]uint64{10: 100, 20: 200})
+	querier := NewQuerier(cdc, testClientKeeper{clientID: clientID, clientState: NewClientState(clienttypes.NewHeight(0, 20)), clientStore: clientStore})
+
+	res, err := querier.ConsensusState(sdk.WrapSDKContext(ctx), &QueryConsensusStateRequest{ClientId: clientID, RevisionHeight: 15})
+	require.NoError(t, err)
+	require.Equal(t, clienttypes.NewHeight(0, 10), res.ConsensusState.Height)
+	require.Equal(t, uint64(100), res.ConsensusState.ConsensusState.Timestamp)
+
+	_, err = querier.ConsensusState(sdk.WrapSDKContext(ctx), &QueryConsensusStateRequest{ClientId: clientID, RevisionHeight: 5})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	statesRes, err := querier.ConsensusStates(sdk.WrapSDKContext(ctx), &QueryConsensusStatesRequest{ClientId: clientID})
+	require.NoError(t, err)
+	require.Len(t, statesRes.ConsensusStates, 2)
+	require.Equal(t, clienttypes.NewHeight(0, 10), statesRes.ConsensusStates[0].Height)
+}
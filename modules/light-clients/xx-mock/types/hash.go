@@ -0,0 +1,65 @@
+package types
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
+)
+
+// Validate returns an error if the hash algorithm is not supported.
+func (a HashAlgorithm) Validate() error {
+	if _, ok := HashAlgorithm_name[int32(a)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidHashAlgorithm, "unknown hash algorithm: %d", a)
+	}
+	return nil
+}
+
+// Validate returns an error if the commitment scheme is not supported.
+func (s CommitmentScheme) Validate() error {
+	if _, ok := CommitmentScheme_name[int32(s)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidCommitmentScheme, "unknown commitment scheme: %d", s)
+	}
+	return nil
+}
+
+// Hash returns the digest of the concatenation of the given data.
+func (a HashAlgorithm) Hash(data ...[]byte) ([]byte, error) {
+	h, err := a.newHash()
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil), nil
+}
+
+// AbsentMarker returns the all-zero digest of the hash algorithm, which is committed
+// in place of the value hash by the committed non-membership proofs.
+func (a HashAlgorithm) AbsentMarker() ([]byte, error) {
+	h, err := a.newHash()
+	if err != nil {
+		return nil, err
+	}
+	return make([]byte, h.Size()), nil
+}
+
+// newHash returns a new hash.Hash computing the digest with the hash algorithm.
+func (a HashAlgorithm) newHash() (hash.Hash, error) {
+	switch a {
+	case HashAlgorithmSHA256:
+		return sha256.New(), nil
+	case HashAlgorithmKeccak256:
+		return sha3.NewLegacyKeccak256(), nil
+	case HashAlgorithmBlake2b256:
+		return blake2b.New256(nil)
+	case HashAlgorithmSHA512_256:
+		return sha512.New512_256(), nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidHashAlgorithm, "unknown hash algorithm: %d", a)
+	}
+}
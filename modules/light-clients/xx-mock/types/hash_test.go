@@ -0,0 +1,37 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashAlgorithmHash(t *testing.T) {
+	testCases := []struct {
+		algorithm HashAlgorithm
+		expDigest string
+	}{
+		{HashAlgorithmSHA256, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{HashAlgorithmKeccak256, "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45"},
+		{HashAlgorithmBlake2b256, "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319"},
+		{HashAlgorithmSHA512_256, "53048e2681941ef99b2e29b76b4c7dabe4c2d0c634fc6d46e0e2f13107e7af23"},
+	}
+
+	for _, tc := range testCases {
+		require.NoError(t, tc.algorithm.Validate())
+
+		digest, err := tc.algorithm.Hash([]byte("abc"))
+		require.NoError(t, err)
+		require.Equal(t, tc.expDigest, hex.EncodeToString(digest), tc.algorithm.String())
+
+		// the data are concatenated
+		digest, err = tc.algorithm.Hash([]byte("a"), []byte("bc"))
+		require.NoError(t, err)
+		require.Equal(t, tc.expDigest, hex.EncodeToString(digest), tc.algorithm.String())
+	}
+
+	require.ErrorIs(t, HashAlgorithm(100).Validate(), ErrInvalidHashAlgorithm)
+	_, err := HashAlgorithm(100).Hash([]byte("abc"))
+	require.ErrorIs(t, err, ErrInvalidHashAlgorithm)
+}
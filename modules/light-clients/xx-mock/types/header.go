@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
@@ -18,8 +19,25 @@ func (h Header) GetHeight() exported.Height {
 	return h.Height
 }
 
-// ValidateBasic ensures that the sequence, signature and public key have all
-// been initialized.
+// ConsensusState returns the consensus state associated with the header.
+func (h Header) ConsensusState() *ConsensusState {
+	return &ConsensusState{
+		Timestamp: h.Timestamp,
+		Root:      h.Root,
+	}
+}
+
+// GetSignBytes returns the bytes signed by the authority, which are the
+// encoding of the header with an empty signature.
+func (h Header) GetSignBytes() ([]byte, error) {
+	h.Signature = nil
+	return h.Marshal()
+}
+
+// ValidateBasic ensures that the timestamp of the header is not zero.
 func (h Header) ValidateBasic() error {
+	if h.Timestamp == 0 {
+		return sdkerrors.Wrapf(ErrZeroHeaderTimestamp, "header at height %s", h.Height)
+	}
 	return nil
 }
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// MigrateConsensusStateIndex sets the iteration key of every consensus state of every Mock client, so that
+// the consensus states stored before the iteration index was introduced can be iterated in the order of their heights.
+// It returns the number of consensus states indexed.
+func MigrateConsensusStateIndex(ctx sdk.Context, clientKeeper ClientKeeper) (int, error) {
+	var clientIDs []string
+	clientKeeper.IterateClientStates(ctx, []byte(Mock), func(clientID string, _ exported.ClientState) bool {
+		clientIDs = append(clientIDs, clientID)
+		return false
+	})
+
+	var totalIndexed int
+	for _, clientID := range clientIDs {
+		clientStore := clientKeeper.ClientStore(ctx, clientID)
+
+		var heights []clienttypes.Height
+		iterator := sdk.KVStorePrefixIterator(clientStore, []byte(host.KeyConsensusStatePrefix+"/"))
+		for ; iterator.Valid(); iterator.Next() {
+			keySplit := strings.Split(string(iterator.Key()), "/")
+			// consensus state key in prefix store has format: "consensusStates/<height>"
+			if len(keySplit) != 2 {
+				// ignore all consensus metadata keys
+				continue
+			}
+
+			height, err := clienttypes.ParseHeight(keySplit[1])
+			if err != nil {
+				iterator.Close()
+				return 0, sdkerrors.Wrapf(err, "client-id: %s, consensus state key: %s", clientID, iterator.Key())
+			}
+			heights = append(heights, height)
+		}
+		iterator.Close()
+
+		for _, height := range heights {
+			setIterationKey(clientStore, height)
+		}
+		totalIndexed += len(heights)
+	}
+
+	ctx.Logger().Info("indexed mock consensus states", "total", totalIndexed)
+	return totalIndexed, nil
+}
+
+// MigrateProcessedHeights rewrites every processed height of every Mock client stored in the legacy string encoding
+// into the 16-byte big-endian encoding. It returns the number of processed heights rewritten.
+func MigrateProcessedHeights(ctx sdk.Context, clientKeeper ClientKeeper) (int, error) {
+	var clientIDs []string
+	clientKeeper.IterateClientStates(ctx, []byte(Mock), func(clientID string, _ exported.ClientState) bool {
+		clientIDs = append(clientIDs, clientID)
+		return false
+	})
+
+	var totalRewritten int
+	for _, clientID := range clientIDs {
+		clientStore := clientKeeper.ClientStore(ctx, clientID)
+
+		var (
+			keys             [][]byte
+			processedHeights []clienttypes.Height
+			err              error
+		)
+		IterateConsensusMetadata(clientStore, func(key, val []byte) bool {
+			// skip the processed times and the iteration keys
+			if !bytes.HasPrefix(key, []byte(host.KeyConsensusStatePrefix)) || !bytes.HasSuffix(key, keyProcessedHeight) {
+				return false
+			}
+
+			var processedHeight clienttypes.Height
+			processedHeight, err = parseProcessedHeight(val)
+			if err != nil {
+				err = sdkerrors.Wrapf(err, "client-id: %s, processed height key: %s", clientID, key)
+				return true
+			}
+			if !isLegacyProcessedHeight(val) {
+				return false
+			}
+			keys = append(keys, key)
+			processedHeights = append(processedHeights, processedHeight)
+			return false
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		for i, key := range keys {
+			clientStore.Set(key, bigEndianHeightBytes(processedHeights[i]))
+		}
+		totalRewritten += len(keys)
+	}
+
+	ctx.Logger().Info("rewrote mock processed heights", "total", totalRewritten)
+	return totalRewritten, nil
+}
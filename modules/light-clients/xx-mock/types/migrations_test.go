@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateConsensusStateIndex(t *testing.T) {
+	ctx, clientStore, cdc := newTestContext(t, time.Unix(1, 0))
+	clientKeeper := testClientKeeper{
+		clientID:    fmt.Sprintf("%s-0", Mock),
+		clientState: NewClientState(clienttypes.NewHeight(0, 10)),
+		clientStore: clientStore,
+	}
+
+	// store the consensus states and their metadata without the iteration keys
+	for _, h := range []clienttypes.Height{clienttypes.NewHeight(0, 10), clienttypes.NewHeight(0, 9)} {
+		clientStore.Set(host.ConsensusStateKey(h), clienttypes.MustMarshalConsensusState(cdc, &ConsensusState{Timestamp: h.RevisionHeight}))
+		setConsensusMetadata(ctx, clientStore, h)
+	}
+	_, _, found, err := getPreviousConsensusState(clientStore, cdc, clienttypes.NewHeight(0, 10))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	indexed, err := MigrateConsensusStateIndex(ctx, clientKeeper)
+	require.NoError(t, err)
+	require.Equal(t, 2, indexed)
+
+	prevHeight, prev, found, err := getPreviousConsensusState(clientStore, cdc, clienttypes.NewHeight(0, 10))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, clienttypes.NewHeight(0, 9), prevHeight)
+	require.Equal(t, uint64(9), prev.Timestamp)
+
+	// the migration is idempotent
+	indexed, err = MigrateConsensusStateIndex(ctx, clientKeeper)
+	require.NoError(t, err)
+	require.Equal(t, 2, indexed)
+}
+
+func TestMigrateConsensusStateIndexSkipsOtherClients(t *testing.T) {
+	ctx, clientStore, cdc := newTestContext(t, time.Unix(1, 0))
+	clientStore.Set(host.ConsensusStateKey(clienttypes.NewHeight(0, 1)), clienttypes.MustMarshalConsensusState(cdc, &ConsensusState{Timestamp: 1}))
+
+	indexed, err := MigrateConsensusStateIndex(ctx, testClientKeeper{clientID: "07-tendermint-0", clientStore: clientStore})
+	require.NoError(t, err)
+	require.Zero(t, indexed)
+	require.False(t, clientStore.Has(IterationKey(clienttypes.NewHeight(0, 1))))
+}
+
+func TestMigrateProcessedHeights(t *testing.T) {
+	ctx, clientStore, _ := newTestContext(t, time.Unix(1, 0))
+	clientKeeper := testClientKeeper{
+		clientID:    fmt.Sprintf("%s-0", Mock),
+		clientState: NewClientState(clienttypes.NewHeight(0, 3)),
+		clientStore: clientStore,
+	}
+
+	legacyHeight, binaryHeight := clienttypes.NewHeight(0, 1), clienttypes.NewHeight(0, 2)
+	// a legacy processed height of 16 characters has the same length as the binary encoding
+	clientStore.Set(ProcessedHeightKey(legacyHeight), []byte("1234567-12345678"))
+	setProcessedHeight(clientStore, binaryHeight, clienttypes.NewHeight(3, 45))
+	setProcessedTime(clientStore, binaryHeight, 1)
+	setIterationKey(clientStore, binaryHeight)
+
+	rewritten, err := MigrateProcessedHeights(ctx, clientKeeper)
+	require.NoError(t, err)
+	require.Equal(t, 1, rewritten)
+	require.Equal(t, bigEndianHeightBytes(clienttypes.NewHeight(1234567, 12345678)), clientStore.Get(ProcessedHeightKey(legacyHeight)))
+	require.Equal(t, bigEndianHeightBytes(clienttypes.NewHeight(3, 45)), clientStore.Get(ProcessedHeightKey(binaryHeight)))
+	require.Equal(t, sdk.Uint64ToBigEndian(1), clientStore.Get(ProcessedTimeKey(binaryHeight)))
+
+	rewritten, err = MigrateProcessedHeights(ctx, clientKeeper)
+	require.NoError(t, err)
+	require.Zero(t, rewritten)
+}
+
+func TestMigrateProcessedHeightsFailsOnInvalidHeight(t *testing.T) {
+	ctx, clientStore, _ := newTestContext(t, time.Unix(1, 0))
+	clientKeeper := testClientKeeper{
+		clientID:    fmt.Sprintf("%s-0", Mock),
+		clientState: NewClientState(clienttypes.NewHeight(0, 1)),
+		clientStore: clientStore,
+	}
+	clientStore.Set(ProcessedHeightKey(clienttypes.NewHeight(0, 1)), []byte("garbage"))
+
+	_, err := MigrateProcessedHeights(ctx, clientKeeper)
+	require.ErrorIs(t, err, ErrInvalidProcessedHeight)
+}
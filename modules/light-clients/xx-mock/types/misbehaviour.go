@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var _ exported.ClientMessage = &Misbehaviour{}
+
+// FrozenHeight is same for all misbehaviour
+var FrozenHeight = clienttypes.NewHeight(0, 1)
+
+// NewMisbehaviour creates a new Misbehaviour instance.
+func NewMisbehaviour(header1, header2 *Header) *Misbehaviour {
+	return &Misbehaviour{
+		Header1: header1,
+		Header2: header2,
+	}
+}
+
+// ClientType returns mock-client type.
+func (Misbehaviour) ClientType() string {
+	return Mock
+}
+
+// ValidateBasic ensures that the misbehaviour consists of two different headers for the same height.
+func (misbehaviour Misbehaviour) ValidateBasic() error {
+	if misbehaviour.Header1 == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "misbehaviour Header1 cannot be nil")
+	}
+	if misbehaviour.Header2 == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "misbehaviour Header2 cannot be nil")
+	}
+	if misbehaviour.Header1.Height.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidHeaderHeight, "misbehaviour Header1 cannot have zero height")
+	}
+	if !misbehaviour.Header1.Height.EQ(misbehaviour.Header2.Height) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidMisbehaviour,
+			"headers must have the same height (%s != %s)", misbehaviour.Header1.Height, misbehaviour.Header2.Height,
+		)
+	}
+	if misbehaviour.Header1.ConsensusState().IsEqual(*misbehaviour.Header2.ConsensusState()) {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "headers are not conflicting")
+	}
+
+	if err := misbehaviour.Header1.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "header 1 failed validation")
+	}
+	if err := misbehaviour.Header2.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "header 2 failed validation")
+	}
+	return nil
+}
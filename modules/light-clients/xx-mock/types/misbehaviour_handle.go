@@ -3,10 +3,42 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
-// CheckForMisbehaviour never detects misbehaviour and always returns false.
-func (cs ClientState) CheckForMisbehaviour(_ sdk.Context, _ codec.BinaryCodec, _ sdk.KVStore, _ exported.ClientMessage) bool {
-	return false
+// CheckForMisbehaviour detects misbehaviour in a submitted Header message and verifies
+// the correctness of a submitted Misbehaviour ClientMessage
+func (cs ClientState) CheckForMisbehaviour(_ sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, msg exported.ClientMessage) bool {
+	switch msg := msg.(type) {
+	case *Header:
+		// Check if the Client store already has a consensus state for the header's height
+		// If the consensus state exists, and it matches the header then the header has already
+		// been submitted in a previous UpdateClient.
+		// If it does not match the header, the header conflicts with the one previously submitted,
+		// which is an implicit misbehaviour.
+		if existingConsState, found := getConsensusState(clientStore, cdc, msg.GetHeight()); found {
+			return !existingConsState.IsEqual(*msg.ConsensusState())
+		}
+		return false
+	case *Misbehaviour:
+		// A Misbehaviour which passed VerifyClientMessage always contains two conflicting headers.
+		return true
+	default:
+		return false
+	}
+}
+
+// verifyMisbehaviour returns an error if either of the conflicting headers is not a valid header for the client.
+func (cs *ClientState) verifyMisbehaviour(
+	ctx sdk.Context, clientStore sdk.KVStore, cdc codec.BinaryCodec,
+	misbehaviour *Misbehaviour,
+) error {
+	if err := cs.verifyHeader(ctx, clientStore, cdc, misbehaviour.Header1); err != nil {
+		return sdkerrors.Wrap(err, "failed to verify Header1")
+	}
+	if err := cs.verifyHeader(ctx, clientStore, cdc, misbehaviour.Header2); err != nil {
+		return sdkerrors.Wrap(err, "failed to verify Header2")
+	}
+	return nil
 }
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMisbehaviourValidateBasic(t *testing.T) {
+	height := clienttypes.NewHeight(0, 1)
+
+	testCases := []struct {
+		name    string
+		header1 *Header
+		header2 *Header
+		expPass bool
+	}{
+		{"different timestamps", &Header{Height: height, Timestamp: 1}, &Header{Height: height, Timestamp: 2}, true},
+		{"different roots", &Header{Height: height, Timestamp: 1, Root: []byte{1}}, &Header{Height: height, Timestamp: 1, Root: []byte{2}}, true},
+		{"identical headers", &Header{Height: height, Timestamp: 1, Root: []byte{1}}, &Header{Height: height, Timestamp: 1, Root: []byte{1}}, false},
+		{"nil and empty roots", &Header{Height: height, Timestamp: 1}, &Header{Height: height, Timestamp: 1, Root: []byte{}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := Misbehaviour{Header1: tc.header1, Header2: tc.header2}.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, clienttypes.ErrInvalidMisbehaviour, tc.name)
+		}
+	}
+}
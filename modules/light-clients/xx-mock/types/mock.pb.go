@@ -5,18 +5,25 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,8 +31,260 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PathKind defines the kind of an ICS-24 path.
+type PathKind int32
+
+const (
+	// path which is not classified as any of the kinds below
+	PathKindUnknown               PathKind = 0
+	PathKindClientState           PathKind = 1
+	PathKindConsensusState        PathKind = 2
+	PathKindConnection            PathKind = 3
+	PathKindChannel               PathKind = 4
+	PathKindPacketCommitment      PathKind = 5
+	PathKindPacketAcknowledgement PathKind = 6
+	PathKindPacketReceipt         PathKind = 7
+	PathKindNextSequenceSend      PathKind = 8
+	PathKindNextSequenceRecv      PathKind = 9
+	PathKindNextSequenceAck       PathKind = 10
+)
+
+var PathKind_name = map[int32]string{
+	0:  "PATH_KIND_UNKNOWN",
+	1:  "PATH_KIND_CLIENT_STATE",
+	2:  "PATH_KIND_CONSENSUS_STATE",
+	3:  "PATH_KIND_CONNECTION",
+	4:  "PATH_KIND_CHANNEL",
+	5:  "PATH_KIND_PACKET_COMMITMENT",
+	6:  "PATH_KIND_PACKET_ACKNOWLEDGEMENT",
+	7:  "PATH_KIND_PACKET_RECEIPT",
+	8:  "PATH_KIND_NEXT_SEQUENCE_SEND",
+	9:  "PATH_KIND_NEXT_SEQUENCE_RECV",
+	10: "PATH_KIND_NEXT_SEQUENCE_ACK",
+}
+
+var PathKind_value = map[string]int32{
+	"PATH_KIND_UNKNOWN":                0,
+	"PATH_KIND_CLIENT_STATE":           1,
+	"PATH_KIND_CONSENSUS_STATE":        2,
+	"PATH_KIND_CONNECTION":             3,
+	"PATH_KIND_CHANNEL":                4,
+	"PATH_KIND_PACKET_COMMITMENT":      5,
+	"PATH_KIND_PACKET_ACKNOWLEDGEMENT": 6,
+	"PATH_KIND_PACKET_RECEIPT":         7,
+	"PATH_KIND_NEXT_SEQUENCE_SEND":     8,
+	"PATH_KIND_NEXT_SEQUENCE_RECV":     9,
+	"PATH_KIND_NEXT_SEQUENCE_ACK":      10,
+}
+
+func (x PathKind) String() string {
+	return proto.EnumName(PathKind_name, int32(x))
+}
+
+func (PathKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a0679be451cd4671, []int{0}
+}
+
+// VerificationPolicy defines how the proofs of a path kind are verified.
+type VerificationPolicy int32
+
+const (
+	// proofs are verified according to the commitment scheme of the client
+	VerificationPolicyVerify VerificationPolicy = 0
+	// any proof is accepted
+	VerificationPolicyAlwaysAccept VerificationPolicy = 1
+	// any proof is rejected
+	VerificationPolicyAlwaysReject VerificationPolicy = 2
+)
+
+var VerificationPolicy_name = map[int32]string{
+	0: "VERIFICATION_POLICY_VERIFY",
+	1: "VERIFICATION_POLICY_ALWAYS_ACCEPT",
+	2: "VERIFICATION_POLICY_ALWAYS_REJECT",
+}
+
+var VerificationPolicy_value = map[string]int32{
+	"VERIFICATION_POLICY_VERIFY":        0,
+	"VERIFICATION_POLICY_ALWAYS_ACCEPT": 1,
+	"VERIFICATION_POLICY_ALWAYS_REJECT": 2,
+}
+
+func (x VerificationPolicy) String() string {
+	return proto.EnumName(VerificationPolicy_name, int32(x))
+}
+
+func (VerificationPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a0679be451cd4671, []int{1}
+}
+
+// HashAlgorithm defines the hash function used to compute the proof commitments.
+type HashAlgorithm int32
+
+const (
+	// SHA-256, which is the default hash function
+	HashAlgorithmSHA256 HashAlgorithm = 0
+	// Keccak-256 as used by Ethereum
+	HashAlgorithmKeccak256 HashAlgorithm = 1
+	// BLAKE2b-256
+	HashAlgorithmBlake2b256 HashAlgorithm = 2
+	// SHA-512/256
+	HashAlgorithmSHA512_256 HashAlgorithm = 3
+)
+
+var HashAlgorithm_name = map[int32]string{
+	0: "HASH_ALGORITHM_SHA256",
+	1: "HASH_ALGORITHM_KECCAK256",
+	2: "HASH_ALGORITHM_BLAKE2B_256",
+	3: "HASH_ALGORITHM_SHA512_256",
+}
+
+var HashAlgorithm_value = map[string]int32{
+	"HASH_ALGORITHM_SHA256":      0,
+	"HASH_ALGORITHM_KECCAK256":   1,
+	"HASH_ALGORITHM_BLAKE2B_256": 2,
+	"HASH_ALGORITHM_SHA512_256":  3,
+}
+
+func (x HashAlgorithm) String() string {
+	return proto.EnumName(HashAlgorithm_name, int32(x))
+}
+
+func (HashAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a0679be451cd4671, []int{2}
+}
+
+// CommitmentScheme defines the scheme of the proofs verified by the client.
+type CommitmentScheme int32
+
+const (
+	// flat hash of the height, path and value computed with the hash algorithm of the client,
+	// which is the default scheme
+	CommitmentSchemeHash CommitmentScheme = 0
+	// ICS-23 merkle proofs with the SDK specs against the root of the consensus state
+	CommitmentSchemeICS23 CommitmentScheme = 1
+)
+
+var CommitmentScheme_name = map[int32]string{
+	0: "COMMITMENT_SCHEME_HASH",
+	1: "COMMITMENT_SCHEME_ICS23",
+}
+
+var CommitmentScheme_value = map[string]int32{
+	"COMMITMENT_SCHEME_HASH":  0,
+	"COMMITMENT_SCHEME_ICS23": 1,
+}
+
+func (x CommitmentScheme) String() string {
+	return proto.EnumName(CommitmentScheme_name, int32(x))
+}
+
+func (CommitmentScheme) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a0679be451cd4671, []int{3}
+}
+
+// FaultTarget defines the verification functions into which a fault is injected.
+type FaultTarget int32
+
+const (
+	// all of the verification functions below
+	FaultTargetAny FaultTarget = 0
+	// VerifyMembership
+	FaultTargetMembership FaultTarget = 1
+	// VerifyNonMembership
+	FaultTargetNonMembership FaultTarget = 2
+	// VerifyClientMessage
+	FaultTargetClientMessage FaultTarget = 3
+)
+
+var FaultTarget_name = map[int32]string{
+	0: "FAULT_TARGET_ANY",
+	1: "FAULT_TARGET_MEMBERSHIP",
+	2: "FAULT_TARGET_NON_MEMBERSHIP",
+	3: "FAULT_TARGET_CLIENT_MESSAGE",
+}
+
+var FaultTarget_value = map[string]int32{
+	"FAULT_TARGET_ANY":            0,
+	"FAULT_TARGET_MEMBERSHIP":     1,
+	"FAULT_TARGET_NON_MEMBERSHIP": 2,
+	"FAULT_TARGET_CLIENT_MESSAGE": 3,
+}
+
+func (x FaultTarget) String() string {
+	return proto.EnumName(FaultTarget_name, int32(x))
+}
+
+func (FaultTarget) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a0679be451cd4671, []int{4}
+}
+
+// ScheduledStatus defines the status of the client set by a StatusTransition.
+type ScheduledStatus int32
+
+const (
+	ScheduledStatusActive  ScheduledStatus = 0
+	ScheduledStatusFrozen  ScheduledStatus = 1
+	ScheduledStatusExpired ScheduledStatus = 2
+	ScheduledStatusUnknown ScheduledStatus = 3
+)
+
+var ScheduledStatus_name = map[int32]string{
+	0: "SCHEDULED_STATUS_ACTIVE",
+	1: "SCHEDULED_STATUS_FROZEN",
+	2: "SCHEDULED_STATUS_EXPIRED",
+	3: "SCHEDULED_STATUS_UNKNOWN",
+}
+
+var ScheduledStatus_value = map[string]int32{
+	"SCHEDULED_STATUS_ACTIVE":  0,
+	"SCHEDULED_STATUS_FROZEN":  1,
+	"SCHEDULED_STATUS_EXPIRED": 2,
+	"SCHEDULED_STATUS_UNKNOWN": 3,
+}
+
+func (x ScheduledStatus) String() string {
+	return proto.EnumName(ScheduledStatus_name, int32(x))
+}
+
+func (ScheduledStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a0679be451cd4671, []int{5}
+}
+
 type ClientState struct {
 	LatestHeight types.Height `protobuf:"bytes,1,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height"`
+	// Block height when the client was frozen due to a misbehaviour
+	FrozenHeight types.Height `protobuf:"bytes,2,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height"`
+	// duration of the period since the latest consensus state timestamp during which the client is active.
+	// Zero means that the client never expires.
+	TrustingPeriod time.Duration `protobuf:"bytes,3,opt,name=trusting_period,json=trustingPeriod,proto3,stdduration" json:"trusting_period"`
+	// Path at which next upgraded client will be committed.
+	// Each element corresponds to the key for a single CommitmentProof in a chained proof.
+	UpgradePath []string `protobuf:"bytes,4,rep,name=upgrade_path,json=upgradePath,proto3" json:"upgrade_path,omitempty"`
+	// hash function used to compute the proof commitments
+	HashAlgorithm HashAlgorithm `protobuf:"varint,5,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=ibc.lightclients.mock.v1.HashAlgorithm" json:"hash_algorithm,omitempty"`
+	// public key of the authority which signs the headers.
+	// It is either a single ed25519 or secp256k1 key, or a threshold multisig key (LegacyAminoPubKey)
+	// whose headers must be signed by at least threshold of its keys.
+	// If it is not set, the client accepts unsigned headers.
+	PublicKey *types1.Any `protobuf:"bytes,6,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// scheme of the proofs verified by the client
+	CommitmentScheme CommitmentScheme `protobuf:"varint,7,opt,name=commitment_scheme,json=commitmentScheme,proto3,enum=ibc.lightclients.mock.v1.CommitmentScheme" json:"commitment_scheme,omitempty"`
+	// if true, non-membership proofs of the hash commitment scheme commit to the height and path
+	// with the absent marker in place of the value hash. Otherwise, only the empty proof is accepted.
+	CommittedNonMembership bool `protobuf:"varint,8,opt,name=committed_non_membership,json=committedNonMembership,proto3" json:"committed_non_membership,omitempty"`
+	// verification policies of the proofs for each path kind.
+	// Proofs of path kinds without a policy are verified.
+	PathPolicies []PathPolicy `protobuf:"bytes,9,rep,name=path_policies,json=pathPolicies,proto3" json:"path_policies"`
+	// faults injected into the verification functions, which are evaluated in order
+	Faults []Fault `protobuf:"bytes,10,rep,name=faults,proto3" json:"faults"`
+	// scheduled transitions of the status of the client.
+	// The last transition whose trigger has been reached determines the status unless the client is frozen by misbehaviour.
+	StatusSchedule []StatusTransition `protobuf:"bytes,11,rep,name=status_schedule,json=statusSchedule,proto3" json:"status_schedule"`
+	// maximum duration by which the timestamp of a header may exceed the block time of the host chain.
+	// Zero means that the timestamps of headers are not checked against the block time.
+	MaxClockDrift time.Duration `protobuf:"bytes,12,opt,name=max_clock_drift,json=maxClockDrift,proto3,stdduration" json:"max_clock_drift"`
+	// policy by which old consensus states are pruned on updates
+	PruningPolicy PruningPolicy `protobuf:"bytes,13,opt,name=pruning_policy,json=pruningPolicy,proto3" json:"pruning_policy"`
 }
 
 func (m *ClientState) Reset()         { *m = ClientState{} }
@@ -61,15 +320,56 @@ func (m *ClientState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClientState proto.InternalMessageInfo
 
+// PathPolicy defines the verification policy of the proofs for a path kind.
+type PathPolicy struct {
+	PathKind PathKind           `protobuf:"varint,1,opt,name=path_kind,json=pathKind,proto3,enum=ibc.lightclients.mock.v1.PathKind" json:"path_kind,omitempty"`
+	Policy   VerificationPolicy `protobuf:"varint,2,opt,name=policy,proto3,enum=ibc.lightclients.mock.v1.VerificationPolicy" json:"policy,omitempty"`
+}
+
+func (m *PathPolicy) Reset()         { *m = PathPolicy{} }
+func (m *PathPolicy) String() string { return proto.CompactTextString(m) }
+func (*PathPolicy) ProtoMessage()    {}
+func (*PathPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0679be451cd4671, []int{1}
+}
+func (m *PathPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PathPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PathPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PathPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PathPolicy.Merge(m, src)
+}
+func (m *PathPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PathPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PathPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PathPolicy proto.InternalMessageInfo
+
 type ConsensusState struct {
 	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// commitment root against which ICS-23 proofs are verified
+	Root []byte `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
 }
 
 func (m *ConsensusState) Reset()         { *m = ConsensusState{} }
 func (m *ConsensusState) String() string { return proto.CompactTextString(m) }
 func (*ConsensusState) ProtoMessage()    {}
 func (*ConsensusState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a0679be451cd4671, []int{1}
+	return fileDescriptor_a0679be451cd4671, []int{2}
 }
 func (m *ConsensusState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -98,16 +398,69 @@ func (m *ConsensusState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConsensusState proto.InternalMessageInfo
 
+// ConsensusStateWithMetadata defines a consensus state with its height
+// and the metadata recorded when the consensus state was processed.
+type ConsensusStateWithMetadata struct {
+	Height         types.Height   `protobuf:"bytes,1,opt,name=height,proto3" json:"height"`
+	ConsensusState ConsensusState `protobuf:"bytes,2,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state"`
+	// time (in nanoseconds) at which the consensus state was processed
+	ProcessedTime uint64 `protobuf:"varint,3,opt,name=processed_time,json=processedTime,proto3" json:"processed_time,omitempty"`
+	// height of the host chain at which the consensus state was processed
+	ProcessedHeight types.Height `protobuf:"bytes,4,opt,name=processed_height,json=processedHeight,proto3" json:"processed_height"`
+}
+
+func (m *ConsensusStateWithMetadata) Reset()         { *m = ConsensusStateWithMetadata{} }
+func (m *ConsensusStateWithMetadata) String() string { return proto.CompactTextString(m) }
+func (*ConsensusStateWithMetadata) ProtoMessage()    {}
+func (*ConsensusStateWithMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0679be451cd4671, []int{3}
+}
+func (m *ConsensusStateWithMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsensusStateWithMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsensusStateWithMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsensusStateWithMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusStateWithMetadata.Merge(m, src)
+}
+func (m *ConsensusStateWithMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsensusStateWithMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusStateWithMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusStateWithMetadata proto.InternalMessageInfo
+
 type Header struct {
 	Height    types.Height `protobuf:"bytes,1,opt,name=height,proto3" json:"height"`
 	Timestamp uint64       `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// signature of the authority over the header with an empty signature.
+	// For a multisig authority, it is an encoded cosmos.crypto.multisig.v1beta1.MultiSignature
+	// whose signatures are ordered as the keys of the authority, with an empty signature for each absent signer.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// public key of the authority which replaces the current one once the header is applied.
+	// It must be signed by the current authority.
+	NewPublicKey *types1.Any `protobuf:"bytes,4,opt,name=new_public_key,json=newPublicKey,proto3" json:"new_public_key,omitempty"`
+	// commitment root of the counterparty store at the height, required by the ICS-23 commitment scheme
+	Root []byte `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
 }
 
 func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a0679be451cd4671, []int{2}
+	return fileDescriptor_a0679be451cd4671, []int{4}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -136,10 +489,248 @@ func (m *Header) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Header proto.InternalMessageInfo
 
+// ExtendedProof is the extended encoding of a proof of the hash commitment scheme,
+// which carries the components of the commitment separately so that the verifier
+// can report which of them mismatched.
+type ExtendedProof struct {
+	Height types.Height `protobuf:"bytes,1,opt,name=height,proto3" json:"height"`
+	// hashes of the keys of the merkle path in order, where the first one is the hash of the prefix
+	KeyHashes [][]byte `protobuf:"bytes,2,rep,name=key_hashes,json=keyHashes,proto3" json:"key_hashes,omitempty"`
+	// hash of the value, or the absent marker for non-membership.
+	// It may be omitted if the value is given.
+	ValueHash []byte `protobuf:"bytes,3,opt,name=value_hash,json=valueHash,proto3" json:"value_hash,omitempty"`
+	// optional preimage of the value hash, i.e. the raw value stored by the counterparty.
+	// If it is given, the verifier reports a field-level diff of the decoded values on a value mismatch.
+	Value []byte `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ExtendedProof) Reset()         { *m = ExtendedProof{} }
+func (m *ExtendedProof) String() string { return proto.CompactTextString(m) }
+func (*ExtendedProof) ProtoMessage()    {}
+func (*ExtendedProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0679be451cd4671, []int{5}
+}
+func (m *ExtendedProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendedProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendedProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtendedProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendedProof.Merge(m, src)
+}
+func (m *ExtendedProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendedProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendedProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendedProof proto.InternalMessageInfo
+
+// Fault defines an error returned by the verification functions for the matching heights and paths.
+type Fault struct {
+	Target FaultTarget `protobuf:"varint,1,opt,name=target,proto3,enum=ibc.lightclients.mock.v1.FaultTarget" json:"target,omitempty"`
+	// lowest height (inclusive) of the proof or the client message at which the fault is injected.
+	// Zero means no lower bound.
+	MinHeight types.Height `protobuf:"bytes,2,opt,name=min_height,json=minHeight,proto3" json:"min_height"`
+	// highest height (inclusive) of the proof or the client message at which the fault is injected.
+	// Zero means no upper bound.
+	MaxHeight types.Height `protobuf:"bytes,3,opt,name=max_height,json=maxHeight,proto3" json:"max_height"`
+	// kinds of the paths of the proofs for which the fault is injected. Empty means any path.
+	// A fault with path kinds is never injected into VerifyClientMessage.
+	PathKinds []PathKind `protobuf:"varint,4,rep,packed,name=path_kinds,json=pathKinds,proto3,enum=ibc.lightclients.mock.v1.PathKind" json:"path_kinds,omitempty"`
+	// codespace of the registered error to return, e.g. "mock-client"
+	Codespace string `protobuf:"bytes,5,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// code of the registered error to return
+	Code uint32 `protobuf:"varint,6,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *Fault) Reset()         { *m = Fault{} }
+func (m *Fault) String() string { return proto.CompactTextString(m) }
+func (*Fault) ProtoMessage()    {}
+func (*Fault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0679be451cd4671, []int{6}
+}
+func (m *Fault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Fault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fault.Merge(m, src)
+}
+func (m *Fault) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fault) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fault proto.InternalMessageInfo
+
+// StatusTransition defines a status of the client from a self height and/or a block time of the host chain.
+// The trigger is reached when all of its non-zero conditions are satisfied.
+type StatusTransition struct {
+	Status ScheduledStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ibc.lightclients.mock.v1.ScheduledStatus" json:"status,omitempty"`
+	// self height (inclusive) of the host chain from which the status applies
+	SelfHeight types.Height `protobuf:"bytes,2,opt,name=self_height,json=selfHeight,proto3" json:"self_height"`
+	// block time (inclusive) of the host chain from which the status applies
+	BlockTime time.Time `protobuf:"bytes,3,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+}
+
+func (m *StatusTransition) Reset()         { *m = StatusTransition{} }
+func (m *StatusTransition) String() string { return proto.CompactTextString(m) }
+func (*StatusTransition) ProtoMessage()    {}
+func (*StatusTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0679be451cd4671, []int{7}
+}
+func (m *StatusTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusTransition.Merge(m, src)
+}
+func (m *StatusTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusTransition proto.InternalMessageInfo
+
+// PruningPolicy defines which consensus states are pruned together with their metadata when the client is updated.
+// The consensus state at the latest height is never pruned. A zero policy disables pruning.
+type PruningPolicy struct {
+	// number of the consensus states at the highest heights to keep. Zero means no limit.
+	KeepLast uint64 `protobuf:"varint,1,opt,name=keep_last,json=keepLast,proto3" json:"keep_last,omitempty"`
+	// maximum duration by which the block time of the host chain may exceed the timestamp of a kept consensus state.
+	// Zero means no limit.
+	MaxAge time.Duration `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3,stdduration" json:"max_age"`
+}
+
+func (m *PruningPolicy) Reset()         { *m = PruningPolicy{} }
+func (m *PruningPolicy) String() string { return proto.CompactTextString(m) }
+func (*PruningPolicy) ProtoMessage()    {}
+func (*PruningPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0679be451cd4671, []int{8}
+}
+func (m *PruningPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PruningPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruningPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PruningPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruningPolicy.Merge(m, src)
+}
+func (m *PruningPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruningPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruningPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruningPolicy proto.InternalMessageInfo
+
+// Misbehaviour is a wrapper over two conflicting Headers
+// that implements Misbehaviour interface expected by ICS-02
+type Misbehaviour struct {
+	Header1 *Header `protobuf:"bytes,1,opt,name=header_1,json=header1,proto3" json:"header_1,omitempty"`
+	Header2 *Header `protobuf:"bytes,2,opt,name=header_2,json=header2,proto3" json:"header_2,omitempty"`
+}
+
+func (m *Misbehaviour) Reset()         { *m = Misbehaviour{} }
+func (m *Misbehaviour) String() string { return proto.CompactTextString(m) }
+func (*Misbehaviour) ProtoMessage()    {}
+func (*Misbehaviour) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0679be451cd4671, []int{9}
+}
+func (m *Misbehaviour) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Misbehaviour) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Misbehaviour.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Misbehaviour) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Misbehaviour.Merge(m, src)
+}
+func (m *Misbehaviour) XXX_Size() int {
+	return m.Size()
+}
+func (m *Misbehaviour) XXX_DiscardUnknown() {
+	xxx_messageInfo_Misbehaviour.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Misbehaviour proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("ibc.lightclients.mock.v1.PathKind", PathKind_name, PathKind_value)
+	proto.RegisterEnum("ibc.lightclients.mock.v1.VerificationPolicy", VerificationPolicy_name, VerificationPolicy_value)
+	proto.RegisterEnum("ibc.lightclients.mock.v1.HashAlgorithm", HashAlgorithm_name, HashAlgorithm_value)
+	proto.RegisterEnum("ibc.lightclients.mock.v1.CommitmentScheme", CommitmentScheme_name, CommitmentScheme_value)
+	proto.RegisterEnum("ibc.lightclients.mock.v1.FaultTarget", FaultTarget_name, FaultTarget_value)
+	proto.RegisterEnum("ibc.lightclients.mock.v1.ScheduledStatus", ScheduledStatus_name, ScheduledStatus_value)
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.mock.v1.ClientState")
+	proto.RegisterType((*PathPolicy)(nil), "ibc.lightclients.mock.v1.PathPolicy")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.mock.v1.ConsensusState")
+	proto.RegisterType((*ConsensusStateWithMetadata)(nil), "ibc.lightclients.mock.v1.ConsensusStateWithMetadata")
 	proto.RegisterType((*Header)(nil), "ibc.lightclients.mock.v1.Header")
+	proto.RegisterType((*ExtendedProof)(nil), "ibc.lightclients.mock.v1.ExtendedProof")
+	proto.RegisterType((*Fault)(nil), "ibc.lightclients.mock.v1.Fault")
+	proto.RegisterType((*StatusTransition)(nil), "ibc.lightclients.mock.v1.StatusTransition")
+	proto.RegisterType((*PruningPolicy)(nil), "ibc.lightclients.mock.v1.PruningPolicy")
+	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.mock.v1.Misbehaviour")
 }
 
 func init() {
@@ -147,27 +738,131 @@ func init() {
 }
 
 var fileDescriptor_a0679be451cd4671 = []byte{
-	// 307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0x13, 0x54, 0x55, 0xc2, 0x05, 0x86, 0x8a, 0xa1, 0xaa, 0x90, 0x8b, 0xca, 0xc2, 0x52,
-	0x5b, 0x85, 0x85, 0xb9, 0x15, 0x52, 0x25, 0xb6, 0xc2, 0xc4, 0x02, 0xb6, 0x6b, 0x25, 0x16, 0x49,
-	0x1c, 0xc5, 0x97, 0xa8, 0xbc, 0x05, 0x8f, 0x95, 0xb1, 0x23, 0x13, 0x82, 0xe4, 0x45, 0x90, 0xed,
-	0x20, 0xfe, 0x6c, 0x4c, 0x3e, 0xdf, 0xfd, 0xee, 0xfb, 0xa4, 0xef, 0xd0, 0x99, 0xe2, 0x82, 0x26,
-	0x2a, 0x8a, 0x41, 0x24, 0x4a, 0x66, 0x60, 0x68, 0xaa, 0xc5, 0x13, 0xad, 0xe6, 0xee, 0x25, 0x79,
-	0xa1, 0x41, 0x0f, 0x47, 0x8a, 0x0b, 0xf2, 0x13, 0x22, 0x6e, 0x58, 0xcd, 0xc7, 0xc7, 0x91, 0x8e,
-	0xb4, 0x83, 0xa8, 0xad, 0x3c, 0x3f, 0x9e, 0x58, 0x51, 0xa1, 0x0b, 0x49, 0x3d, 0x6f, 0xe5, 0x7c,
-	0xe5, 0x81, 0xe9, 0x1d, 0x1a, 0x2c, 0xdd, 0xff, 0x16, 0x18, 0xc8, 0xe1, 0x35, 0x3a, 0x4c, 0x18,
-	0x48, 0x03, 0x0f, 0xb1, 0xb4, 0x2e, 0xa3, 0xf0, 0x34, 0x3c, 0x1f, 0x5c, 0x8c, 0x89, 0xf5, 0xb5,
-	0x3a, 0xa4, 0xdb, 0xae, 0xe6, 0x64, 0xe5, 0x88, 0x45, 0xaf, 0x7e, 0x9b, 0x04, 0xeb, 0x03, 0xbf,
-	0xe6, 0x7b, 0x53, 0x82, 0x8e, 0x96, 0x3a, 0x33, 0x32, 0x33, 0xa5, 0xf1, 0xc2, 0x27, 0x68, 0x1f,
-	0x54, 0x2a, 0x0d, 0xb0, 0x34, 0x77, 0xa2, 0xbd, 0xf5, 0x77, 0x63, 0xfa, 0x88, 0xfa, 0x2b, 0xc9,
-	0x36, 0xb2, 0x18, 0x5e, 0xa1, 0xfe, 0x3f, 0x9d, 0x3b, 0xfe, 0xb7, 0xc3, 0xde, 0x1f, 0x87, 0x85,
-	0xaa, 0x3f, 0x70, 0x50, 0x37, 0x38, 0xdc, 0x35, 0x38, 0x7c, 0x6f, 0x70, 0xf8, 0xd2, 0xe2, 0x60,
-	0xd7, 0xe2, 0xe0, 0xb5, 0xc5, 0xc1, 0xfd, 0x4d, 0xa4, 0x20, 0x2e, 0x39, 0x11, 0x3a, 0xa5, 0x1b,
-	0x06, 0x4c, 0xc4, 0x4c, 0x65, 0x09, 0xe3, 0x54, 0x71, 0x31, 0xb3, 0x09, 0xcf, 0xba, 0xf8, 0x52,
-	0xbd, 0x29, 0x13, 0x69, 0xfc, 0x9d, 0x66, 0x5f, 0x87, 0xda, 0x6e, 0x1d, 0x44, 0xe1, 0x39, 0x97,
-	0x86, 0xf7, 0x5d, 0xb2, 0x97, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x11, 0x58, 0x70, 0xd7, 0xd1,
-	0x01, 0x00, 0x00,
+	// 1980 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x98, 0xcf, 0x73, 0xe3, 0x48,
+	0x15, 0xc7, 0x2d, 0xe7, 0xa7, 0x3b, 0x71, 0xa2, 0xed, 0xcd, 0x66, 0x14, 0x67, 0xd6, 0xf1, 0x18,
+	0xb6, 0x08, 0x53, 0x8c, 0x4d, 0x3c, 0xec, 0xb2, 0xc0, 0x0c, 0x5b, 0xb2, 0xa2, 0xc4, 0xc6, 0xb6,
+	0x62, 0x24, 0x65, 0xb2, 0xb3, 0x17, 0x55, 0x5b, 0xee, 0xd8, 0x5a, 0xdb, 0x92, 0x91, 0xda, 0x19,
+	0x9b, 0x3f, 0x80, 0x02, 0x9f, 0xb6, 0x8a, 0x0b, 0x17, 0x5f, 0xd8, 0x7f, 0x81, 0x2b, 0xf7, 0x29,
+	0x8a, 0xc3, 0x1e, 0xb9, 0xb0, 0xc0, 0xcc, 0x85, 0x13, 0x17, 0x8e, 0x70, 0xa0, 0xba, 0x25, 0xf9,
+	0x67, 0x92, 0xcd, 0x30, 0x27, 0x4b, 0xaf, 0xdf, 0xe7, 0xab, 0x7e, 0xaf, 0x5f, 0xbf, 0x96, 0x0c,
+	0xbe, 0x65, 0xd5, 0xcc, 0x6c, 0xdb, 0x6a, 0x34, 0x89, 0xd9, 0xb6, 0xb0, 0x4d, 0xbc, 0x6c, 0xc7,
+	0x31, 0x5b, 0xd9, 0xab, 0x23, 0xf6, 0x9b, 0xe9, 0xba, 0x0e, 0x71, 0xa0, 0x60, 0xd5, 0xcc, 0xcc,
+	0xb4, 0x53, 0x86, 0x0d, 0x5e, 0x1d, 0x25, 0x76, 0x1a, 0x4e, 0xc3, 0x61, 0x4e, 0x59, 0x7a, 0xe5,
+	0xfb, 0x27, 0xf6, 0x1a, 0x8e, 0xd3, 0x68, 0xe3, 0x2c, 0xbb, 0xab, 0xf5, 0x2e, 0xb3, 0xc8, 0x1e,
+	0x04, 0x43, 0xc9, 0xf9, 0xa1, 0x7a, 0xcf, 0x45, 0xc4, 0x72, 0xec, 0x60, 0xfc, 0x60, 0x7e, 0x9c,
+	0x58, 0x1d, 0xec, 0x11, 0xd4, 0xe9, 0x86, 0xda, 0xa6, 0xe3, 0x75, 0x1c, 0xcf, 0xf0, 0x1f, 0xea,
+	0xdf, 0x84, 0x2c, 0x8d, 0xc5, 0x74, 0x5c, 0x9c, 0xf5, 0xa7, 0x49, 0xa3, 0xf0, 0xaf, 0x7c, 0x87,
+	0xf4, 0x9f, 0xd7, 0xc0, 0x86, 0xc4, 0x0c, 0x1a, 0x41, 0x04, 0x43, 0x19, 0xc4, 0xdb, 0x88, 0x60,
+	0x8f, 0x18, 0x4d, 0x4c, 0xa3, 0x13, 0xb8, 0x14, 0x77, 0xb8, 0x91, 0x4b, 0x64, 0x68, 0xbc, 0x54,
+	0x28, 0x13, 0xe0, 0x57, 0x47, 0x99, 0x02, 0xf3, 0xc8, 0x2f, 0xbf, 0xfc, 0xfa, 0x20, 0xa2, 0x6e,
+	0xfa, 0x98, 0x6f, 0xa3, 0x32, 0x97, 0xae, 0xf3, 0x4b, 0x6c, 0x87, 0x32, 0xd1, 0xbb, 0xca, 0xf8,
+	0x58, 0x20, 0x53, 0x06, 0xdb, 0xc4, 0xed, 0x79, 0xc4, 0xb2, 0x1b, 0x46, 0x17, 0xbb, 0x96, 0x53,
+	0x17, 0x96, 0x98, 0xd0, 0x5e, 0xc6, 0x4f, 0x4a, 0x26, 0x4c, 0x4a, 0xe6, 0x38, 0x48, 0x5a, 0x7e,
+	0x9d, 0xea, 0xfc, 0xee, 0x6f, 0x07, 0x9c, 0xba, 0x15, 0xb2, 0x55, 0x86, 0xc2, 0x07, 0x60, 0xb3,
+	0xd7, 0x6d, 0xb8, 0xa8, 0x8e, 0x8d, 0x2e, 0x22, 0x4d, 0x61, 0x39, 0xb5, 0x74, 0x18, 0x53, 0x37,
+	0x02, 0x5b, 0x15, 0x91, 0x26, 0x54, 0xc0, 0x56, 0x13, 0x79, 0x4d, 0x03, 0xb5, 0x1b, 0x8e, 0x6b,
+	0x91, 0x66, 0x47, 0x58, 0x49, 0x71, 0x87, 0x5b, 0xb9, 0xef, 0x64, 0x6e, 0x5a, 0xef, 0x4c, 0x01,
+	0x79, 0x4d, 0x31, 0x74, 0x57, 0xe3, 0xcd, 0xe9, 0x5b, 0x58, 0x01, 0xa0, 0xdb, 0xab, 0xb5, 0x2d,
+	0xd3, 0x68, 0xe1, 0x81, 0xb0, 0xca, 0xe6, 0xbe, 0xb3, 0x30, 0x77, 0xd1, 0x1e, 0xe4, 0x85, 0x3f,
+	0xfd, 0xe1, 0xd1, 0x4e, 0xb0, 0x76, 0xa6, 0x3b, 0xe8, 0x12, 0x27, 0x53, 0xed, 0xd5, 0x4a, 0x78,
+	0xa0, 0xc6, 0x7c, 0x85, 0x12, 0x1e, 0xc0, 0x0b, 0xf0, 0x8e, 0xe9, 0x74, 0x3a, 0x16, 0xe9, 0x60,
+	0x9b, 0x18, 0x9e, 0xd9, 0xc4, 0x1d, 0x2c, 0xac, 0xb1, 0x19, 0x3e, 0xbc, 0x79, 0x86, 0xd2, 0x18,
+	0xd1, 0x18, 0xa1, 0xf2, 0xe6, 0x9c, 0x05, 0x7e, 0x0c, 0x04, 0xdf, 0x46, 0x70, 0xdd, 0xb0, 0x1d,
+	0xdb, 0xe8, 0xe0, 0x4e, 0x0d, 0xbb, 0x5e, 0xd3, 0xea, 0x0a, 0xeb, 0x29, 0xee, 0x70, 0x5d, 0xdd,
+	0x1d, 0x8f, 0x2b, 0x8e, 0x5d, 0x19, 0x8f, 0xc2, 0x33, 0x10, 0xa7, 0xc9, 0x34, 0xba, 0x4e, 0xdb,
+	0x32, 0x2d, 0xec, 0x09, 0xb1, 0xd4, 0xd2, 0xe1, 0x46, 0xee, 0xdb, 0x37, 0x4f, 0x87, 0x26, 0xba,
+	0x4a, 0xbd, 0x07, 0xe1, 0x9a, 0x77, 0x43, 0x8b, 0x85, 0x3d, 0xf8, 0x14, 0xac, 0x5e, 0xa2, 0x5e,
+	0x9b, 0x78, 0x02, 0x60, 0x4a, 0x07, 0x37, 0x2b, 0x9d, 0x50, 0xbf, 0x40, 0x24, 0x80, 0xe0, 0x73,
+	0xb0, 0xed, 0x11, 0x44, 0x7a, 0x1e, 0x4b, 0x4f, 0xbd, 0xd7, 0xc6, 0xc2, 0x06, 0xd3, 0xb9, 0x25,
+	0x41, 0x1a, 0x03, 0x74, 0x17, 0xd9, 0x9e, 0xc5, 0x6a, 0xc8, 0x97, 0xdc, 0xf2, 0x85, 0xb4, 0x40,
+	0x07, 0x96, 0xc0, 0x76, 0x07, 0xf5, 0x0d, 0xb3, 0xed, 0x98, 0x2d, 0xa3, 0xee, 0x5a, 0x97, 0x44,
+	0xd8, 0xbc, 0x7b, 0x35, 0xc6, 0x3b, 0xa8, 0x2f, 0x51, 0xf4, 0x98, 0x92, 0x50, 0x07, 0x5b, 0x5d,
+	0xb7, 0x67, 0xb3, 0xca, 0x66, 0xc9, 0x10, 0xe2, 0x4c, 0xeb, 0x96, 0x4a, 0xab, 0xfa, 0xfe, 0x33,
+	0xb9, 0x8b, 0x77, 0xa7, 0x8d, 0xe9, 0xdf, 0x72, 0x00, 0x4c, 0xf2, 0x0b, 0x3f, 0x01, 0x31, 0xb6,
+	0x38, 0x2d, 0xcb, 0xae, 0xb3, 0x9d, 0xbc, 0x95, 0x4b, 0xdf, 0xbe, 0x30, 0x25, 0xcb, 0xae, 0xab,
+	0xeb, 0xdd, 0xe0, 0x0a, 0x1e, 0x83, 0xd5, 0x60, 0x76, 0x51, 0x46, 0x7f, 0xef, 0x66, 0xfa, 0x19,
+	0x76, 0xad, 0x4b, 0xcb, 0x64, 0x61, 0xfb, 0x8f, 0x57, 0x03, 0x36, 0x9d, 0x07, 0x5b, 0x92, 0x63,
+	0x7b, 0xd8, 0xf6, 0x7a, 0x9e, 0xdf, 0x66, 0xee, 0x83, 0xd8, 0xb8, 0x8b, 0xb1, 0x89, 0x2d, 0xab,
+	0x13, 0x03, 0x84, 0x60, 0xd9, 0x75, 0x1c, 0xbf, 0x69, 0x6c, 0xaa, 0xec, 0x3a, 0xfd, 0x65, 0x14,
+	0x24, 0x66, 0x45, 0x2e, 0x2c, 0xd2, 0xac, 0x60, 0x82, 0xea, 0x88, 0x20, 0xf8, 0x31, 0x58, 0x7d,
+	0xc3, 0x86, 0x15, 0xf8, 0xc3, 0x0b, 0xb0, 0x6d, 0x86, 0xba, 0x06, 0x5d, 0x71, 0x1c, 0x34, 0xab,
+	0xc3, 0xdb, 0x76, 0xd4, 0xf4, 0x44, 0xc2, 0x72, 0x31, 0x67, 0x63, 0xfc, 0x80, 0xae, 0xb0, 0x63,
+	0x62, 0xcf, 0xc3, 0x75, 0x83, 0x06, 0xc7, 0x7a, 0xd7, 0xb2, 0x1a, 0x1f, 0x5b, 0x75, 0xab, 0x43,
+	0xab, 0x8a, 0x9f, 0xb8, 0x05, 0x31, 0x2c, 0xdf, 0x31, 0x86, 0xed, 0x31, 0xe9, 0x9b, 0xd3, 0xff,
+	0xe4, 0xc0, 0x6a, 0x01, 0xa3, 0x3a, 0x76, 0xdf, 0x22, 0x23, 0x33, 0x8b, 0x13, 0x9d, 0x5f, 0x9c,
+	0xfb, 0x20, 0xe6, 0x59, 0x0d, 0x1b, 0x91, 0x9e, 0xeb, 0x47, 0xb4, 0xa9, 0x4e, 0x0c, 0xb4, 0xac,
+	0x6d, 0xfc, 0xc2, 0x98, 0x6a, 0x7a, 0xcb, 0xff, 0x57, 0xd3, 0xdb, 0xb4, 0xf1, 0x8b, 0xea, 0xb8,
+	0xef, 0x85, 0x05, 0xb1, 0x32, 0x55, 0x10, 0x23, 0x0e, 0xc4, 0xe5, 0x3e, 0xc1, 0x76, 0x1d, 0xd7,
+	0xab, 0xae, 0xe3, 0x5c, 0xbe, 0x45, 0xc4, 0xef, 0x03, 0xd0, 0xc2, 0x03, 0x83, 0xf6, 0x6e, 0xec,
+	0x09, 0xd1, 0xd4, 0x12, 0x0d, 0xaa, 0x85, 0x07, 0x05, 0x66, 0xa0, 0xc3, 0x57, 0xa8, 0xdd, 0xc3,
+	0xcc, 0x21, 0x8c, 0x99, 0x59, 0xa8, 0x03, 0xdc, 0x01, 0x2b, 0xec, 0x86, 0x85, 0xba, 0xa9, 0xfa,
+	0x37, 0xe9, 0x3f, 0x46, 0xc1, 0x0a, 0x6b, 0x50, 0xb4, 0xa3, 0x11, 0xe4, 0x36, 0x30, 0x09, 0xb6,
+	0xe0, 0x07, 0xdf, 0xd0, 0xd1, 0x74, 0xe6, 0xac, 0x06, 0x10, 0xfc, 0x04, 0x80, 0x8e, 0xf5, 0xc6,
+	0x07, 0x69, 0xac, 0x63, 0x85, 0xa7, 0x28, 0x15, 0x40, 0xfd, 0x50, 0x60, 0xe9, 0xce, 0x02, 0xa8,
+	0x1f, 0x08, 0x88, 0x00, 0x8c, 0xdb, 0x88, 0xc7, 0x8e, 0xcd, 0xbb, 0xf5, 0x91, 0x58, 0xd8, 0x47,
+	0x3c, 0x5a, 0x35, 0xa6, 0x53, 0xc7, 0x5e, 0x17, 0x99, 0x98, 0x2d, 0x63, 0x4c, 0x9d, 0x18, 0xe8,
+	0xfa, 0xd2, 0x1b, 0x76, 0x40, 0xc6, 0x55, 0x76, 0x9d, 0xfe, 0x2b, 0x07, 0xf8, 0xf9, 0xc6, 0x0c,
+	0x45, 0xb0, 0xea, 0x37, 0xe5, 0x20, 0x95, 0xdf, 0xbd, 0xa5, 0xa9, 0x07, 0x6d, 0xbb, 0xee, 0x8b,
+	0xa8, 0x01, 0x08, 0x45, 0xb0, 0xe1, 0xe1, 0xf6, 0xe5, 0x9b, 0xe6, 0x13, 0x50, 0x28, 0xc8, 0x87,
+	0x04, 0x40, 0x8d, 0x1d, 0x02, 0xe3, 0x5d, 0x4d, 0x15, 0xe6, 0x0b, 0x5c, 0x0f, 0xb7, 0x8c, 0x7f,
+	0x08, 0x7c, 0x41, 0x0f, 0x81, 0x18, 0xe3, 0xe8, 0x48, 0xfa, 0x73, 0x10, 0x9f, 0x69, 0xe8, 0x70,
+	0x1f, 0xc4, 0x5a, 0x18, 0x77, 0x8d, 0x36, 0xf2, 0x48, 0xd0, 0x13, 0xd7, 0xa9, 0xa1, 0x8c, 0x3c,
+	0x02, 0x9f, 0x80, 0x35, 0xba, 0x86, 0xa8, 0x11, 0x76, 0xa7, 0x3b, 0x9d, 0x39, 0xab, 0x1d, 0xd4,
+	0x17, 0x1b, 0x38, 0xfd, 0x7b, 0x0e, 0x6c, 0x56, 0x2c, 0xaf, 0x86, 0x9b, 0xe8, 0xca, 0x72, 0x7a,
+	0x2e, 0x2c, 0x80, 0xf5, 0x26, 0x6b, 0x13, 0xc6, 0x51, 0xb0, 0x59, 0x52, 0xb7, 0xbc, 0xe1, 0x30,
+	0xcf, 0xfc, 0xc6, 0xab, 0xaf, 0x0f, 0xd6, 0xfc, 0xeb, 0x23, 0x75, 0xcd, 0xc7, 0x8f, 0xa6, 0x94,
+	0x72, 0x42, 0xf4, 0xcd, 0x95, 0x72, 0xa1, 0x52, 0xee, 0xe1, 0x6f, 0x56, 0xc0, 0x7a, 0x58, 0x3a,
+	0xf0, 0x21, 0x78, 0xa7, 0x2a, 0xea, 0x05, 0xa3, 0x54, 0x54, 0x8e, 0x8d, 0x73, 0xa5, 0xa4, 0x9c,
+	0x5d, 0x28, 0x7c, 0x24, 0xf1, 0xee, 0x70, 0x94, 0xda, 0x0e, 0x9d, 0xce, 0xed, 0x96, 0xed, 0xbc,
+	0xb0, 0xe1, 0x63, 0xb0, 0x3b, 0xf1, 0x95, 0xca, 0x45, 0x59, 0xd1, 0x0d, 0x4d, 0x17, 0x75, 0x99,
+	0xe7, 0x12, 0xf7, 0x86, 0xa3, 0xd4, 0xbb, 0x21, 0x30, 0xfd, 0xa2, 0xfb, 0x23, 0xb0, 0x37, 0x05,
+	0x9d, 0x29, 0x9a, 0xac, 0x68, 0xe7, 0x5a, 0xc0, 0x45, 0x13, 0x89, 0xe1, 0x28, 0xb5, 0x3b, 0xe6,
+	0x66, 0x1b, 0xfb, 0xf7, 0xc1, 0xce, 0x0c, 0xaa, 0xc8, 0x92, 0x5e, 0x3c, 0x53, 0xf8, 0xa5, 0xc4,
+	0xee, 0x70, 0x94, 0x82, 0x53, 0x94, 0x8d, 0x4d, 0x56, 0xb6, 0x33, 0xd1, 0x48, 0x05, 0x51, 0x51,
+	0xe4, 0x32, 0xbf, 0x3c, 0x1b, 0x8d, 0xd4, 0x44, 0xb6, 0x8d, 0xdb, 0xf0, 0x29, 0xd8, 0x9f, 0xf8,
+	0x56, 0x45, 0xa9, 0x24, 0xeb, 0x86, 0x74, 0x56, 0xa9, 0x14, 0xf5, 0x8a, 0xac, 0xe8, 0xfc, 0x4a,
+	0xe2, 0xfe, 0x70, 0x94, 0x12, 0x42, 0xaa, 0x8a, 0xcc, 0x16, 0x26, 0x93, 0x37, 0x3c, 0x78, 0x0a,
+	0x52, 0x0b, 0xb8, 0x28, 0xd1, 0xfc, 0x95, 0xe5, 0xe3, 0x53, 0x99, 0x69, 0xac, 0x26, 0x1e, 0x0c,
+	0x47, 0xa9, 0xf7, 0x67, 0x35, 0x44, 0x93, 0x66, 0xb3, 0x8d, 0xeb, 0x0d, 0xcc, 0x84, 0x7e, 0x08,
+	0x84, 0x05, 0x21, 0x55, 0x96, 0xe4, 0x62, 0x55, 0xe7, 0xd7, 0x12, 0x7b, 0xc3, 0x51, 0xea, 0xbd,
+	0x59, 0x01, 0x15, 0x9b, 0xd8, 0xea, 0x12, 0xf8, 0x53, 0x70, 0x7f, 0x02, 0x2a, 0xf2, 0xa7, 0xba,
+	0xa1, 0xc9, 0x3f, 0x3f, 0x97, 0x15, 0x49, 0x36, 0x34, 0x59, 0x39, 0xe6, 0xd7, 0x67, 0x23, 0x50,
+	0x70, 0x9f, 0x68, 0xf8, 0x17, 0x3d, 0x6c, 0x9b, 0x58, 0xc3, 0x76, 0xfd, 0x36, 0x5e, 0x95, 0xa5,
+	0x67, 0x7c, 0xec, 0x66, 0x5e, 0xc5, 0xe6, 0x15, 0x7c, 0x02, 0xf6, 0x6f, 0xe2, 0x45, 0xa9, 0xc4,
+	0x83, 0xc4, 0xfe, 0x70, 0x94, 0xba, 0x77, 0x1d, 0x2e, 0x9a, 0xad, 0xc4, 0xf2, 0xaf, 0xbf, 0x4c,
+	0x46, 0x1e, 0xfe, 0x8b, 0x03, 0x70, 0xf1, 0x85, 0x06, 0x3e, 0x01, 0x89, 0x67, 0xb2, 0x5a, 0x3c,
+	0x29, 0x4a, 0x22, 0x5d, 0x71, 0xa3, 0x7a, 0x56, 0x2e, 0x4a, 0xcf, 0x0d, 0x66, 0x7b, 0xce, 0x47,
+	0xfc, 0x89, 0x2d, 0x72, 0xcc, 0x32, 0x80, 0x45, 0xf0, 0xe0, 0x3a, 0x5a, 0x2c, 0x5f, 0x88, 0xcf,
+	0x35, 0x43, 0x94, 0x24, 0xb9, 0xaa, 0xf3, 0x5c, 0x22, 0x3d, 0x1c, 0xa5, 0x92, 0x8b, 0x22, 0x62,
+	0xfb, 0x05, 0x1a, 0x78, 0xa2, 0x69, 0xe2, 0x2e, 0xf9, 0x06, 0x29, 0x55, 0xfe, 0x99, 0x2c, 0xe9,
+	0x7c, 0xf4, 0x76, 0x29, 0x15, 0x7f, 0x8e, 0x4d, 0x12, 0x04, 0xfc, 0x5f, 0x0e, 0xc4, 0x67, 0xbe,
+	0x64, 0x60, 0x0e, 0xbc, 0x57, 0x10, 0xb5, 0x82, 0x21, 0x96, 0x4f, 0xcf, 0xd4, 0xa2, 0x5e, 0xa8,
+	0x18, 0x5a, 0x41, 0xcc, 0x7d, 0xf8, 0x11, 0x1f, 0xf1, 0x37, 0xd5, 0x8c, 0xb7, 0x3f, 0x44, 0x3f,
+	0x23, 0xe6, 0x98, 0x92, 0x2c, 0x49, 0x62, 0x89, 0x62, 0x9c, 0xbf, 0xa7, 0x66, 0xb0, 0x12, 0x36,
+	0x4d, 0xd4, 0xa2, 0xe4, 0x4f, 0x40, 0x62, 0x8e, 0xcc, 0x97, 0xc5, 0x92, 0x9c, 0xcb, 0x1b, 0x94,
+	0x8d, 0xfa, 0x6b, 0x36, 0xc3, 0xe6, 0xdb, 0xa8, 0x85, 0x73, 0x35, 0x0a, 0xff, 0x18, 0xec, 0x2d,
+	0x4e, 0xf5, 0xc3, 0xa3, 0x1c, 0x63, 0x97, 0xae, 0x61, 0x27, 0xc3, 0x41, 0xf8, 0xbf, 0xe2, 0x00,
+	0x3f, 0xff, 0x99, 0x04, 0x7f, 0x00, 0x76, 0x27, 0x1b, 0xcf, 0xd0, 0xa4, 0x82, 0x5c, 0x91, 0x0d,
+	0xfa, 0x20, 0x3e, 0x92, 0x10, 0x86, 0xa3, 0xd4, 0xce, 0x3c, 0xc1, 0xde, 0x06, 0x3e, 0x02, 0xf7,
+	0x16, 0xa9, 0xa2, 0xa4, 0xe5, 0x1e, 0xf3, 0x9c, 0xbf, 0x6d, 0xe6, 0x31, 0x36, 0x18, 0x4c, 0xe4,
+	0xdf, 0x1c, 0xd8, 0x98, 0x7a, 0x09, 0x80, 0x87, 0x80, 0x3f, 0x11, 0xcf, 0xcb, 0xba, 0xa1, 0x8b,
+	0xea, 0x29, 0xdd, 0xca, 0x0a, 0xad, 0x33, 0x38, 0x1c, 0xa5, 0xb6, 0xa6, 0xdc, 0x44, 0x7b, 0x40,
+	0x9f, 0x3b, 0xe3, 0x59, 0x91, 0x2b, 0x79, 0x59, 0xd5, 0x0a, 0xc5, 0x6a, 0xf8, 0xdc, 0x29, 0x60,
+	0xea, 0x03, 0xee, 0x29, 0xd8, 0x9f, 0xe1, 0x94, 0x33, 0x65, 0x9a, 0x8d, 0xfa, 0x45, 0x3d, 0xc5,
+	0xce, 0x7e, 0xff, 0xcd, 0xe3, 0x41, 0xff, 0xad, 0xc8, 0x9a, 0x26, 0x9e, 0xca, 0xfc, 0xd2, 0x02,
+	0xee, 0x37, 0xe1, 0x0a, 0xf6, 0x3c, 0xd4, 0xc0, 0x41, 0xd4, 0xff, 0xe1, 0xc0, 0xf6, 0xdc, 0x79,
+	0x4d, 0xe3, 0xa1, 0xc9, 0x3b, 0x3e, 0x2f, 0xcb, 0xc7, 0xac, 0x2d, 0x9f, 0xd3, 0x3d, 0xa2, 0x17,
+	0x9f, 0xc9, 0x7c, 0xc4, 0x8f, 0x67, 0x8e, 0x10, 0x4d, 0x62, 0x5d, 0xe1, 0x6b, 0xb9, 0x13, 0xf5,
+	0xec, 0x33, 0x59, 0xe1, 0xb9, 0x6b, 0xb9, 0x13, 0xf6, 0x8f, 0x03, 0xad, 0xdd, 0x05, 0x4e, 0xfe,
+	0xb4, 0x5a, 0x54, 0xe5, 0xe3, 0xf0, 0x3c, 0x98, 0x03, 0xe5, 0x7e, 0xd7, 0x72, 0x71, 0xfd, 0x5a,
+	0x32, 0x3c, 0xb2, 0x96, 0xae, 0x25, 0x83, 0x93, 0xcb, 0x8f, 0x3e, 0x6f, 0xbd, 0xfc, 0x47, 0x32,
+	0xf2, 0xf2, 0x55, 0x92, 0xfb, 0xea, 0x55, 0x92, 0xfb, 0xfb, 0xab, 0x24, 0xf7, 0xc5, 0xeb, 0x64,
+	0xe4, 0xab, 0xd7, 0xc9, 0xc8, 0x5f, 0x5e, 0x27, 0x23, 0x9f, 0x95, 0x1a, 0x16, 0x69, 0xf6, 0x6a,
+	0x19, 0xd3, 0xe9, 0x64, 0xe9, 0xa7, 0x8e, 0xd9, 0x44, 0x96, 0xdd, 0x46, 0xb5, 0xac, 0x55, 0x33,
+	0x1f, 0xd1, 0x83, 0xf5, 0x51, 0xf0, 0xd7, 0x4e, 0xc7, 0xa1, 0x4f, 0xf0, 0xfc, 0xbf, 0xae, 0x1e,
+	0x85, 0xff, 0x5d, 0xf5, 0xfb, 0xcc, 0x29, 0x4b, 0x06, 0x5d, 0xec, 0xd5, 0x56, 0xd9, 0xdb, 0xc2,
+	0xe3, 0xff, 0x0d, 0x00, 0x89, 0xc8, 0x47, 0x48, 0xe4, 0x12, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -191,7 +886,7 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	{
-		size, err := m.LatestHeight.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.PruningPolicy.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -199,39 +894,256 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintMock(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ConsensusState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	dAtA[i] = 0x6a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxClockDrift, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxClockDrift):])
+	if err2 != nil {
+		return 0, err2
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConsensusState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintMock(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x8
+	i -= n2
+	i = encodeVarintMock(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x62
+	if len(m.StatusSchedule) > 0 {
+		for iNdEx := len(m.StatusSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StatusSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Header) Marshal() (dAtA []byte, err error) {
+	if len(m.Faults) > 0 {
+		for iNdEx := len(m.Faults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Faults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PathPolicies) > 0 {
+		for iNdEx := len(m.PathPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PathPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.CommittedNonMembership {
+		i--
+		if m.CommittedNonMembership {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CommitmentScheme != 0 {
+		i = encodeVarintMock(dAtA, i, uint64(m.CommitmentScheme))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PublicKey != nil {
+		{
+			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HashAlgorithm != 0 {
+		i = encodeVarintMock(dAtA, i, uint64(m.HashAlgorithm))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.UpgradePath) > 0 {
+		for iNdEx := len(m.UpgradePath) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpgradePath[iNdEx])
+			copy(dAtA[i:], m.UpgradePath[iNdEx])
+			i = encodeVarintMock(dAtA, i, uint64(len(m.UpgradePath[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMock(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.FrozenHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.LatestHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PathPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PathPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PathPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		i = encodeVarintMock(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PathKind != 0 {
+		i = encodeVarintMock(dAtA, i, uint64(m.PathKind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsensusState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintMock(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintMock(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsensusStateWithMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusStateWithMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusStateWithMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProcessedHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ProcessedTime != 0 {
+		i = encodeVarintMock(dAtA, i, uint64(m.ProcessedTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Header) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -251,6 +1163,32 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintMock(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NewPublicKey != nil {
+		{
+			size, err := m.NewPublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMock(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Timestamp != 0 {
 		i = encodeVarintMock(dAtA, i, uint64(m.Timestamp))
 		i--
@@ -261,69 +1199,1974 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintMock(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintMock(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMock(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ClientState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.LatestHeight.Size()
-	n += 1 + l + sovMock(uint64(l))
-	return n
-}
-
-func (m *ConsensusState) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= size
+		i = encodeVarintMock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtendedProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtendedProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtendedProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMock(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValueHash) > 0 {
+		i -= len(m.ValueHash)
+		copy(dAtA[i:], m.ValueHash)
+		i = encodeVarintMock(dAtA, i, uint64(len(m.ValueHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeyHashes) > 0 {
+		for iNdEx := len(m.KeyHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyHashes[iNdEx])
+			copy(dAtA[i:], m.KeyHashes[iNdEx])
+			i = encodeVarintMock(dAtA, i, uint64(len(m.KeyHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Fault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		i = encodeVarintMock(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintMock(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PathKinds) > 0 {
+		dAtA14 := make([]byte, len(m.PathKinds)*10)
+		var j13 int
+		for _, num := range m.PathKinds {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintMock(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.MaxHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.MinHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Target != 0 {
+		i = encodeVarintMock(dAtA, i, uint64(m.Target))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintMock(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.SelfHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintMock(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PruningPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruningPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PruningPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAge):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintMock(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x12
+	if m.KeepLast != 0 {
+		i = encodeVarintMock(dAtA, i, uint64(m.KeepLast))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Misbehaviour) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Misbehaviour) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Misbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header2 != nil {
+		{
+			size, err := m.Header2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header1 != nil {
+		{
+			size, err := m.Header1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMock(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMock(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClientState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LatestHeight.Size()
+	n += 1 + l + sovMock(uint64(l))
+	l = m.FrozenHeight.Size()
+	n += 1 + l + sovMock(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod)
+	n += 1 + l + sovMock(uint64(l))
+	if len(m.UpgradePath) > 0 {
+		for _, s := range m.UpgradePath {
+			l = len(s)
+			n += 1 + l + sovMock(uint64(l))
+		}
+	}
+	if m.HashAlgorithm != 0 {
+		n += 1 + sovMock(uint64(m.HashAlgorithm))
+	}
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovMock(uint64(l))
+	}
+	if m.CommitmentScheme != 0 {
+		n += 1 + sovMock(uint64(m.CommitmentScheme))
+	}
+	if m.CommittedNonMembership {
+		n += 2
+	}
+	if len(m.PathPolicies) > 0 {
+		for _, e := range m.PathPolicies {
+			l = e.Size()
+			n += 1 + l + sovMock(uint64(l))
+		}
+	}
+	if len(m.Faults) > 0 {
+		for _, e := range m.Faults {
+			l = e.Size()
+			n += 1 + l + sovMock(uint64(l))
+		}
+	}
+	if len(m.StatusSchedule) > 0 {
+		for _, e := range m.StatusSchedule {
+			l = e.Size()
+			n += 1 + l + sovMock(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxClockDrift)
+	n += 1 + l + sovMock(uint64(l))
+	l = m.PruningPolicy.Size()
+	n += 1 + l + sovMock(uint64(l))
+	return n
+}
+
+func (m *PathPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PathKind != 0 {
+		n += 1 + sovMock(uint64(m.PathKind))
+	}
+	if m.Policy != 0 {
+		n += 1 + sovMock(uint64(m.Policy))
+	}
+	return n
+}
+
+func (m *ConsensusState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovMock(uint64(m.Timestamp))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovMock(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsensusStateWithMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Height.Size()
+	n += 1 + l + sovMock(uint64(l))
+	l = m.ConsensusState.Size()
+	n += 1 + l + sovMock(uint64(l))
+	if m.ProcessedTime != 0 {
+		n += 1 + sovMock(uint64(m.ProcessedTime))
+	}
+	l = m.ProcessedHeight.Size()
+	n += 1 + l + sovMock(uint64(l))
+	return n
+}
+
+func (m *Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Height.Size()
+	n += 1 + l + sovMock(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovMock(uint64(m.Timestamp))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMock(uint64(l))
+	}
+	if m.NewPublicKey != nil {
+		l = m.NewPublicKey.Size()
+		n += 1 + l + sovMock(uint64(l))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovMock(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtendedProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Height.Size()
+	n += 1 + l + sovMock(uint64(l))
+	if len(m.KeyHashes) > 0 {
+		for _, b := range m.KeyHashes {
+			l = len(b)
+			n += 1 + l + sovMock(uint64(l))
+		}
+	}
+	l = len(m.ValueHash)
+	if l > 0 {
+		n += 1 + l + sovMock(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMock(uint64(l))
+	}
+	return n
+}
+
+func (m *Fault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Target != 0 {
+		n += 1 + sovMock(uint64(m.Target))
+	}
+	l = m.MinHeight.Size()
+	n += 1 + l + sovMock(uint64(l))
+	l = m.MaxHeight.Size()
+	n += 1 + l + sovMock(uint64(l))
+	if len(m.PathKinds) > 0 {
+		l = 0
+		for _, e := range m.PathKinds {
+			l += sovMock(uint64(e))
+		}
+		n += 1 + sovMock(uint64(l)) + l
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovMock(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovMock(uint64(m.Code))
+	}
+	return n
+}
+
+func (m *StatusTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovMock(uint64(m.Status))
+	}
+	l = m.SelfHeight.Size()
+	n += 1 + l + sovMock(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovMock(uint64(l))
+	return n
+}
+
+func (m *PruningPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeepLast != 0 {
+		n += 1 + sovMock(uint64(m.KeepLast))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAge)
+	n += 1 + l + sovMock(uint64(l))
+	return n
+}
+
+func (m *Misbehaviour) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header1 != nil {
+		l = m.Header1.Size()
+		n += 1 + l + sovMock(uint64(l))
+	}
+	if m.Header2 != nil {
+		l = m.Header2.Size()
+		n += 1 + l + sovMock(uint64(l))
+	}
+	return n
+}
+
+func sovMock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMock(x uint64) (n int) {
+	return sovMock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClientState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FrozenHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TrustingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradePath = append(m.UpgradePath, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublicKey == nil {
+				m.PublicKey = &types1.Any{}
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentScheme", wireType)
+			}
+			m.CommitmentScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitmentScheme |= CommitmentScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommittedNonMembership", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommittedNonMembership = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathPolicies = append(m.PathPolicies, PathPolicy{})
+			if err := m.PathPolicies[len(m.PathPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Faults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Faults = append(m.Faults, Fault{})
+			if err := m.Faults[len(m.Faults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusSchedule = append(m.StatusSchedule, StatusTransition{})
+			if err := m.StatusSchedule[len(m.StatusSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClockDrift", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxClockDrift, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PruningPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PathPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PathPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PathPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathKind", wireType)
+			}
+			m.PathKind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PathKind |= PathKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= VerificationPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusStateWithMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusStateWithMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusStateWithMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedTime", wireType)
+			}
+			m.ProcessedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProcessedHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPublicKey == nil {
+				m.NewPublicKey = &types1.Any{}
+			}
+			if err := m.NewPublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtendedProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendedProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendedProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyHashes = append(m.KeyHashes, make([]byte, postIndex-iNdEx))
+			copy(m.KeyHashes[len(m.KeyHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueHash = append(m.ValueHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValueHash == nil {
+				m.ValueHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			m.Target = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Target |= FaultTarget(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v PathKind
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMock
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PathKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PathKinds = append(m.PathKinds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMock
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMock
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMock
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PathKinds) == 0 {
+					m.PathKinds = make([]PathKind, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PathKind
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMock
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PathKind(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PathKinds = append(m.PathKinds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathKinds", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovMock(uint64(m.Timestamp))
-	}
-	return n
-}
 
-func (m *Header) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Height.Size()
-	n += 1 + l + sovMock(uint64(l))
-	if m.Timestamp != 0 {
-		n += 1 + sovMock(uint64(m.Timestamp))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMock(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMock(x uint64) (n int) {
-	return sovMock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ClientState) Unmarshal(dAtA []byte) error {
+func (m *StatusTransition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -346,15 +3189,34 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientState: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatusTransition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatusTransition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ScheduledStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -381,7 +3243,40 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SelfHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -406,7 +3301,7 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsensusState) Unmarshal(dAtA []byte) error {
+func (m *PruningPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -429,17 +3324,17 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsensusState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PruningPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PruningPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepLast", wireType)
 			}
-			m.Timestamp = 0
+			m.KeepLast = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMock
@@ -449,11 +3344,44 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				m.KeepLast |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMock(dAtA[iNdEx:])
@@ -475,7 +3403,7 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Header) Unmarshal(dAtA []byte) error {
+func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -498,15 +3426,15 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+			return fmt.Errorf("proto: Misbehaviour: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Misbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header1", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -533,15 +3461,18 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Header1 == nil {
+				m.Header1 = &Header{}
+			}
+			if err := m.Header1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header2", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMock
@@ -551,11 +3482,28 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header2 == nil {
+				m.Header2 = &Header{}
+			}
+			if err := m.Header2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMock(dAtA[iNdEx:])
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// ClassifyPath returns the kind of the ICS-24 path, which is the last key of the merkle path.
+// PathKindUnknown is returned if the path is not a merkle path or does not match any ICS-24 path.
+func ClassifyPath(path exported.Path) PathKind {
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok || len(merklePath.KeyPath) == 0 {
+		return PathKindUnknown
+	}
+	key, err := merklePath.GetKey(uint64(len(merklePath.KeyPath) - 1))
+	if err != nil {
+		return PathKindUnknown
+	}
+
+	parts := strings.Split(string(key), "/")
+	switch parts[0] {
+	case string(host.KeyClientStorePrefix):
+		switch {
+		case len(parts) == 3 && parts[2] == host.KeyClientState:
+			return PathKindClientState
+		case len(parts) == 4 && parts[2] == host.KeyConsensusStatePrefix:
+			return PathKindConsensusState
+		}
+	case host.KeyConnectionPrefix:
+		return PathKindConnection
+	case host.KeyChannelEndPrefix:
+		return PathKindChannel
+	case host.KeyPacketCommitmentPrefix:
+		return PathKindPacketCommitment
+	case host.KeyPacketAckPrefix:
+		return PathKindPacketAcknowledgement
+	case host.KeyPacketReceiptPrefix:
+		return PathKindPacketReceipt
+	case host.KeyNextSeqSendPrefix:
+		return PathKindNextSequenceSend
+	case host.KeyNextSeqRecvPrefix:
+		return PathKindNextSequenceRecv
+	case host.KeyNextSeqAckPrefix:
+		return PathKindNextSequenceAck
+	}
+	return PathKindUnknown
+}
+
+// Validate returns an error if the path kind or the verification policy is not supported.
+func (p PathPolicy) Validate() error {
+	if _, ok := PathKind_name[int32(p.PathKind)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPathPolicy, "unknown path kind: %d", p.PathKind)
+	}
+	if _, ok := VerificationPolicy_name[int32(p.Policy)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPathPolicy, "unknown verification policy: %d", p.Policy)
+	}
+	return nil
+}
+
+// VerificationPolicy returns the verification policy of the proofs for the given path kind.
+func (cs ClientState) VerificationPolicy(kind PathKind) VerificationPolicy {
+	for _, p := range cs.PathPolicies {
+		if p.PathKind == kind {
+			return p.Policy
+		}
+	}
+	return VerificationPolicyVerify
+}
+
+// checkVerificationPolicy returns whether the proof for the path must be verified according to the verification policy
+// of its path kind. An error is returned if the policy rejects any proof.
+func (cs ClientState) checkVerificationPolicy(path exported.Path) (bool, error) {
+	kind := ClassifyPath(path)
+	switch cs.VerificationPolicy(kind) {
+	case VerificationPolicyAlwaysAccept:
+		return false, nil
+	case VerificationPolicyAlwaysReject:
+		return false, sdkerrors.Wrapf(ErrRejectedByPolicy, "proofs of %s paths are always rejected: %s", kind, path)
+	default:
+		return true, nil
+	}
+}
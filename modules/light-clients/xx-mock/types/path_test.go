@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/stretchr/testify/require"
+)
+
+// pathsByKind are an ICS-24 path of each kind.
+var pathsByKind = map[PathKind]string{
+	PathKindClientState:           host.FullClientStatePath("07-tendermint-0"),
+	PathKindConsensusState:        host.FullConsensusStatePath("07-tendermint-0", clienttypes.NewHeight(0, 1)),
+	PathKindConnection:            host.ConnectionPath("connection-0"),
+	PathKindChannel:               host.ChannelPath("transfer", "channel-0"),
+	PathKindPacketCommitment:      host.PacketCommitmentPath("transfer", "channel-0", 1),
+	PathKindPacketAcknowledgement: host.PacketAcknowledgementPath("transfer", "channel-0", 1),
+	PathKindPacketReceipt:         host.PacketReceiptPath("transfer", "channel-0", 1),
+	PathKindNextSequenceSend:      host.NextSequenceSendPath("transfer", "channel-0"),
+	PathKindNextSequenceRecv:      host.NextSequenceRecvPath("transfer", "channel-0"),
+	PathKindNextSequenceAck:       host.NextSequenceAckPath("transfer", "channel-0"),
+	PathKindUnknown:               "unknown/path",
+}
+
+func TestClassifyPath(t *testing.T) {
+	for kind, path := range pathsByKind {
+		require.Equal(t, kind, ClassifyPath(commitmenttypes.NewMerklePath("ibc", path)), path)
+	}
+
+	for _, path := range []exported.Path{
+		commitmenttypes.NewMerklePath(),
+		commitmenttypes.NewMerklePath("ibc", "clients/07-tendermint-0"),
+		commitmenttypes.NewMerklePath("ibc", "clients/07-tendermint-0/connections"),
+		unknownPath{},
+	} {
+		require.Equal(t, PathKindUnknown, ClassifyPath(path), path.String())
+	}
+}
+
+func TestVerificationPolicies(t *testing.T) {
+	height := clienttypes.NewHeight(0, 5)
+	value := []byte("value")
+
+	for kind, p := range pathsByKind {
+		path := commitmenttypes.NewMerklePath("ibc", p)
+		for _, policy := range []VerificationPolicy{VerificationPolicyVerify, VerificationPolicyAlwaysAccept, VerificationPolicyAlwaysReject} {
+			kind, policy := kind, policy
+			t.Run(kind.String()+"/"+policy.String(), func(t *testing.T) {
+				ctx, clientStore, cdc := newTestContext(t, time.Unix(1, 0))
+				cs := NewClientState(height)
+				cs.PathPolicies = []PathPolicy{{PathKind: kind, Policy: policy}}
+				require.NoError(t, cs.Validate())
+				require.NoError(t, cs.Initialize(ctx, cdc, clientStore, &ConsensusState{Timestamp: 1}))
+
+				validProof, err := cs.MembershipProof(height, path, value)
+				require.NoError(t, err)
+				invalidProof := []byte("invalid")
+
+				validErr := cs.VerifyMembership(ctx, clientStore, cdc, height, 0, 0, validProof, path, value)
+				invalidErr := cs.VerifyMembership(ctx, clientStore, cdc, height, 0, 0, invalidProof, path, value)
+				absenceErr := cs.VerifyNonMembership(ctx, clientStore, cdc, height, 0, 0, invalidProof, path)
+				switch policy {
+				case VerificationPolicyVerify:
+					require.NoError(t, validErr)
+					require.Error(t, invalidErr)
+					require.Error(t, absenceErr)
+				case VerificationPolicyAlwaysAccept:
+					require.NoError(t, validErr)
+					require.NoError(t, invalidErr)
+					require.NoError(t, absenceErr)
+				case VerificationPolicyAlwaysReject:
+					require.ErrorIs(t, validErr, ErrRejectedByPolicy)
+					require.ErrorIs(t, invalidErr, ErrRejectedByPolicy)
+					require.ErrorIs(t, absenceErr, ErrRejectedByPolicy)
+				}
+
+				// the policy applies only to the paths of its kind
+				otherPath := commitmenttypes.NewMerklePath("ibc", pathsByKind[(kind+1)%PathKind(len(pathsByKind))])
+				require.Error(t, cs.VerifyMembership(ctx, clientStore, cdc, height, 0, 0, invalidProof, otherPath, value))
+			})
+		}
+	}
+}
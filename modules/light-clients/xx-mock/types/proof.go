@@ -0,0 +1,277 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// MembershipProof returns the proof that VerifyMembership expects for the value at the given path and height.
+// The proof is computed as follows, where H is the hash algorithm of the client (sha256 by default):
+// H(abi.encodePacked(height.toUint128(), H(prefix), H(path), H(value)))
+// For a merkle path with more keys, the hashes of all the keys are concatenated in order in place of H(prefix), H(path).
+// The proofs of the ICS-23 commitment scheme cannot be computed by the client and an error is returned.
+func (cs ClientState) MembershipProof(height exported.Height, path exported.Path, value []byte) ([]byte, error) {
+	proof, err := cs.membershipExtendedProof(height, path, value)
+	if err != nil {
+		return nil, err
+	}
+	return proof.Commitment(cs.HashAlgorithm)
+}
+
+// ExtendedMembershipProof returns the membership proof in the extended encoding, which VerifyMembership also accepts.
+// If the proof does not match, the verifier reports which of the height, prefix, path or value mismatched.
+func (cs ClientState) ExtendedMembershipProof(height exported.Height, path exported.Path, value []byte) ([]byte, error) {
+	proof, err := cs.membershipExtendedProof(height, path, value)
+	if err != nil {
+		return nil, err
+	}
+	return proof.Marshal()
+}
+
+// PreimageMembershipProof returns the membership proof in the extended encoding carrying the value itself.
+// If the value does not match, the verifier reports a field-level diff of the decoded values.
+func (cs ClientState) PreimageMembershipProof(height exported.Height, path exported.Path, value []byte) ([]byte, error) {
+	proof, err := cs.membershipExtendedProof(height, path, value)
+	if err != nil {
+		return nil, err
+	}
+	proof.Value = value
+	return proof.Marshal()
+}
+
+// NonMembershipProof returns the proof that VerifyNonMembership expects for the absence of the given path at the given height.
+// By default, Mock client accepts only the empty proof for non-membership with the hash commitment scheme.
+// If CommittedNonMembership is set, the proof is computed as the membership proof with the absent marker,
+// which is the all-zero digest of the hash algorithm, in place of H(value):
+// H(abi.encodePacked(height.toUint128(), H(prefix), H(path), bytes32(0)))
+// The proofs of the ICS-23 commitment scheme cannot be computed by the client and an error is returned.
+func (cs ClientState) NonMembershipProof(height exported.Height, path exported.Path) ([]byte, error) {
+	if err := cs.requireHashCommitmentScheme(); err != nil {
+		return nil, err
+	}
+	if !cs.CommittedNonMembership {
+		return []byte{}, nil
+	}
+
+	proof, err := cs.nonMembershipExtendedProof(height, path)
+	if err != nil {
+		return nil, err
+	}
+	return proof.Commitment(cs.HashAlgorithm)
+}
+
+// ExtendedNonMembershipProof returns the committed non-membership proof in the extended encoding,
+// which VerifyNonMembership also accepts if CommittedNonMembership is set.
+func (cs ClientState) ExtendedNonMembershipProof(height exported.Height, path exported.Path) ([]byte, error) {
+	if err := cs.requireHashCommitmentScheme(); err != nil {
+		return nil, err
+	}
+	if !cs.CommittedNonMembership {
+		return nil, sdkerrors.Wrap(ErrInvalidProof, "extended non-membership proofs require committed non-membership")
+	}
+
+	proof, err := cs.nonMembershipExtendedProof(height, path)
+	if err != nil {
+		return nil, err
+	}
+	return proof.Marshal()
+}
+
+// requireHashCommitmentScheme returns an error if the client does not use the hash commitment scheme,
+// whose proofs are the only ones the client can compute.
+func (cs ClientState) requireHashCommitmentScheme() error {
+	if cs.CommitmentScheme != CommitmentSchemeHash {
+		return sdkerrors.Wrapf(ErrInvalidCommitmentScheme, "proofs cannot be computed for the commitment scheme %s", cs.CommitmentScheme)
+	}
+	return nil
+}
+
+// membershipExtendedProof returns the components of the membership proof of the value.
+func (cs ClientState) membershipExtendedProof(height exported.Height, path exported.Path, value []byte) (*ExtendedProof, error) {
+	if err := cs.requireHashCommitmentScheme(); err != nil {
+		return nil, err
+	}
+	hashValue, err := cs.HashAlgorithm.Hash(value)
+	if err != nil {
+		return nil, err
+	}
+	return cs.extendedProof(height, path, hashValue)
+}
+
+// nonMembershipExtendedProof returns the components of the committed non-membership proof.
+func (cs ClientState) nonMembershipExtendedProof(height exported.Height, path exported.Path) (*ExtendedProof, error) {
+	absentMarker, err := cs.HashAlgorithm.AbsentMarker()
+	if err != nil {
+		return nil, err
+	}
+	return cs.extendedProof(height, path, absentMarker)
+}
+
+// extendedProof returns the components of the proof with the hashes of all the keys of the merkle path and the given value hash.
+func (cs ClientState) extendedProof(height exported.Height, path exported.Path, hashValue []byte) (*ExtendedProof, error) {
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+	if len(merklePath.KeyPath) == 0 {
+		return nil, sdkerrors.Wrap(host.ErrInvalidPath, "merkle path must have at least one key")
+	}
+
+	keyHashes := make([][]byte, len(merklePath.KeyPath))
+	for i := range merklePath.KeyPath {
+		key, err := merklePath.GetKey(uint64(i))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid merkle path key at index %d", i)
+		}
+		keyHashes[i], err = cs.HashAlgorithm.Hash(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ExtendedProof{
+		Height:    clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()),
+		KeyHashes: keyHashes,
+		ValueHash: hashValue,
+	}, nil
+}
+
+// verifyMembershipProof verifies the proof of the existence of the value at the path against the consensus state at the given height,
+// according to the commitment scheme of the client.
+func (cs ClientState) verifyMembershipProof(
+	cdc codec.BinaryCodec, consensusState *ConsensusState, height exported.Height, proof []byte, path exported.Path, value []byte,
+) error {
+	if cs.CommitmentScheme == CommitmentSchemeICS23 {
+		merkleProof, merklePath, err := unmarshalMerkleProof(cdc, proof, path)
+		if err != nil {
+			return err
+		}
+		return merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), consensusState.GetRoot(), merklePath, value)
+	}
+
+	expected, err := cs.membershipExtendedProof(height, path, value)
+	if err != nil {
+		return err
+	}
+	if err := cs.verifyHashProof(expected, proof); err != nil {
+		return cs.describeValueMismatch(cdc, err, path, value, proof)
+	}
+	return nil
+}
+
+// verifyNonMembershipProof verifies the proof of the absence of the path against the consensus state at the given height,
+// according to the commitment scheme of the client.
+func (cs ClientState) verifyNonMembershipProof(
+	cdc codec.BinaryCodec, consensusState *ConsensusState, height exported.Height, proof []byte, path exported.Path,
+) error {
+	if cs.CommitmentScheme == CommitmentSchemeICS23 {
+		merkleProof, merklePath, err := unmarshalMerkleProof(cdc, proof, path)
+		if err != nil {
+			return err
+		}
+		return merkleProof.VerifyNonMembership(commitmenttypes.GetSDKSpecs(), consensusState.GetRoot(), merklePath)
+	}
+
+	if !cs.CommittedNonMembership {
+		if len(proof) != 0 {
+			return sdkerrors.Wrapf(ErrInvalidProof, "expected the empty proof, actually got '%X'", proof)
+		}
+		return nil
+	}
+
+	expected, err := cs.nonMembershipExtendedProof(height, path)
+	if err != nil {
+		return err
+	}
+	if err := cs.verifyHashProof(expected, proof); err != nil {
+		return cs.describeValueMismatch(cdc, err, path, nil, proof)
+	}
+	return nil
+}
+
+// verifyHashProof verifies the proof of the hash commitment scheme against the expected components.
+// A proof with the length of a digest is compared with the commitment of the components,
+// otherwise it is decoded as an ExtendedProof and compared component by component.
+func (cs ClientState) verifyHashProof(expected *ExtendedProof, proof []byte) error {
+	commitment, err := expected.Commitment(cs.HashAlgorithm)
+	if err != nil {
+		return err
+	}
+	if len(proof) == len(commitment) {
+		if !bytes.Equal(proof, commitment) {
+			return sdkerrors.Wrapf(ErrInvalidProof, "expected the proof '%X', actually got '%X'", commitment, proof)
+		}
+		return nil
+	}
+
+	actual, err := cs.decodeExtendedProof(proof)
+	if err != nil {
+		return err
+	}
+	return expected.Compare(*actual)
+}
+
+// decodeExtendedProof decodes the proof in the extended encoding.
+// A proof without a height or key hashes, such as an empty proof, is rejected as malformed.
+// If the proof carries the value, the value hash is computed from it or checked against it.
+func (cs ClientState) decodeExtendedProof(proof []byte) (*ExtendedProof, error) {
+	var extendedProof ExtendedProof
+	if err := extendedProof.Unmarshal(proof); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "failed to decode the proof '%X' as an extended proof: %v", proof, err)
+	}
+	if extendedProof.Height.IsZero() || len(extendedProof.KeyHashes) == 0 {
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "malformed proof '%X': an extended proof must have a non-zero height and key hashes", proof)
+	}
+	if extendedProof.Value == nil {
+		return &extendedProof, nil
+	}
+
+	hashValue, err := cs.HashAlgorithm.Hash(extendedProof.Value)
+	if err != nil {
+		return nil, err
+	}
+	if len(extendedProof.ValueHash) == 0 {
+		extendedProof.ValueHash = hashValue
+	} else if !bytes.Equal(extendedProof.ValueHash, hashValue) {
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "the value hash '%X' of the proof is not the hash of its value '%X'", extendedProof.ValueHash, hashValue)
+	}
+	return &extendedProof, nil
+}
+
+// describeValueMismatch adds a field-level diff of the expected value and the value carried by the proof
+// to a value mismatch error. Other errors, and proofs without the value, are returned as is.
+// A nil expected value means that the path is expected to be absent.
+func (cs ClientState) describeValueMismatch(cdc codec.BinaryCodec, err error, path exported.Path, expected, proof []byte) error {
+	if !errors.Is(err, ErrProofValueMismatch) {
+		return err
+	}
+	actual, decodeErr := cs.decodeExtendedProof(proof)
+	if decodeErr != nil || actual.Value == nil {
+		return err
+	}
+	if expected == nil {
+		return sdkerrors.Wrapf(err, "the path is expected to be absent, but the proof has the value '%X'", actual.Value)
+	}
+	return sdkerrors.Wrap(err, diffValues(cdc, path, expected, actual.Value))
+}
+
+// unmarshalMerkleProof unmarshals the ICS-23 merkle proof and asserts the path to be a merkle path.
+func unmarshalMerkleProof(cdc codec.BinaryCodec, proof []byte, path exported.Path) (commitmenttypes.MerkleProof, commitmenttypes.MerklePath, error) {
+	var merkleProof commitmenttypes.MerkleProof
+	if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return commitmenttypes.MerkleProof{}, commitmenttypes.MerklePath{}, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+	}
+
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return commitmenttypes.MerkleProof{}, commitmenttypes.MerklePath{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+	return merkleProof, merklePath, nil
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/stretchr/testify/require"
+)
+
+// queryICS23Proof commits the key-value pairs into an IAVL store mounted under the key "ibc" of a multistore,
+// and returns the app hash and the ICS-23 proof of the key queried from the multistore.
+func queryICS23Proof(t *testing.T, cdc codec.BinaryCodec, kvs map[string]string, key string) ([]byte, []byte) {
+	t.Helper()
+
+	storeKey := storetypes.NewKVStoreKey("ibc")
+	multiStore := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	multiStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, multiStore.LoadLatestVersion())
+
+	store := multiStore.GetKVStore(storeKey)
+	for k, v := range kvs {
+		store.Set([]byte(k), []byte(v))
+	}
+	commitID := multiStore.Commit()
+
+	res := multiStore.Query(abci.RequestQuery{Path: "/ibc/key", Data: []byte(key), Height: commitID.Version, Prove: true})
+	require.Zero(t, res.Code, res.Log)
+	merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	require.NoError(t, err)
+	proof, err := cdc.Marshal(&merkleProof)
+	require.NoError(t, err)
+	return commitID.Hash, proof
+}
+
+func TestVerifyICS23Proofs(t *testing.T) {
+	ctx, clientStore, cdc := newTestContext(t, time.Unix(1, 0))
+	kvs := map[string]string{"connections/connection-0": "connection", "channelEnds/ports/transfer/channels/channel-0": "channel"}
+	height := clienttypes.NewHeight(0, 5)
+
+	root, proof := queryICS23Proof(t, cdc, kvs, "connections/connection-0")
+	_, absenceProof := queryICS23Proof(t, cdc, kvs, "connections/connection-1")
+
+	cs := NewClientState(height)
+	cs.CommitmentScheme = CommitmentSchemeICS23
+	require.NoError(t, cs.Initialize(ctx, cdc, clientStore, &ConsensusState{Timestamp: 1, Root: root}))
+
+	path := commitmenttypes.NewMerklePath("ibc", "connections/connection-0")
+	require.NoError(t, cs.VerifyMembership(ctx, clientStore, cdc, height, 0, 0, proof, path, []byte("connection")))
+	require.Error(t, cs.VerifyMembership(ctx, clientStore, cdc, height, 0, 0, proof, path, []byte("other")), "wrong value")
+
+	absentPath := commitmenttypes.NewMerklePath("ibc", "connections/connection-1")
+	require.NoError(t, cs.VerifyNonMembership(ctx, clientStore, cdc, height, 0, 0, absenceProof, absentPath))
+	require.Error(t, cs.VerifyNonMembership(ctx, clientStore, cdc, height, 0, 0, proof, path), "present key")
+
+	// the proofs do not verify against another root
+	otherRoot, _ := queryICS23Proof(t, cdc, map[string]string{"connections/connection-0": "other"}, "connections/connection-0")
+	otherHeight := clienttypes.NewHeight(0, 6)
+	cs.LatestHeight = otherHeight
+	setConsensusState(clientStore, cdc, &ConsensusState{Timestamp: 2, Root: otherRoot}, otherHeight)
+	require.Error(t, cs.VerifyMembership(ctx, clientStore, cdc, otherHeight, 0, 0, proof, path, []byte("connection")))
+	require.Error(t, cs.VerifyNonMembership(ctx, clientStore, cdc, otherHeight, 0, 0, absenceProof, absentPath))
+}
+
+// unknownPath is an exported.Path which is not a merkle path.
+type unknownPath struct{}
+
+var _ exported.Path = unknownPath{}
+
+func (unknownPath) String() string { return "unknown" }
+func (unknownPath) Empty() bool    { return false }
+
+func TestMembershipProofCommitment(t *testing.T) {
+	cs := NewClientState(clienttypes.NewHeight(1, 10))
+	height := clienttypes.NewHeight(1, 10)
+
+	// sha256(abi.encodePacked(uint128(height), sha256("ibc"), sha256(path), sha256("value"))) as computed by
+	// the two-key encoding of the Solidity MockClient
+	proof, err := cs.MembershipProof(height, commitmenttypes.NewMerklePath("ibc", "clients/07-tendermint-0/clientState"), []byte("value"))
+	require.NoError(t, err)
+	require.Equal(t, "2b90ad14138ed01895994961dabaa20ace892996780b8c588358ca77298d6ba3", hex.EncodeToString(proof))
+
+	// every key of the path is hashed in order
+	proof, err = cs.MembershipProof(height, commitmenttypes.NewMerklePath("ibc", "a", "b"), []byte("value"))
+	require.NoError(t, err)
+	require.Equal(t, "e39d0daa55f1046081685849cac9e47c9879081145b6ba84b8062398bfad6c56", hex.EncodeToString(proof))
+
+	_, err = cs.MembershipProof(height, unknownPath{}, []byte("value"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+}
+
+func TestVerifyMembershipProofRejectsMalformedExtendedProofs(t *testing.T) {
+	_, _, cdc := newTestContext(t, time.Unix(1, 0))
+	cs := NewClientState(clienttypes.NewHeight(0, 5))
+	height := clienttypes.NewHeight(0, 5)
+	path := commitmenttypes.NewMerklePath("ibc", "connections/connection-0")
+	value := []byte("value")
+	consensusState := &ConsensusState{Timestamp: 1}
+
+	proof, err := cs.ExtendedMembershipProof(height, path, value)
+	require.NoError(t, err)
+	require.NoError(t, cs.verifyMembershipProof(cdc, consensusState, height, proof, path, value))
+
+	noKeyHashes, err := (&ExtendedProof{Height: height, ValueHash: []byte{1}}).Marshal()
+	require.NoError(t, err)
+	for _, proof := range [][]byte{nil, {}, noKeyHashes} {
+		err := cs.verifyMembershipProof(cdc, consensusState, height, proof, path, value)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		require.NotErrorIs(t, err, ErrProofHeightMismatch)
+		require.Contains(t, err.Error(), "malformed proof")
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"reflect"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -8,9 +10,81 @@ import (
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
-// CheckSubstituteAndUpdateState always returns an error because Mock client doesn't support substitute.
+// CheckSubstituteAndUpdateState will try to update the client with the state of the
+// substitute.
+//
+// The following must always be true:
+//   - The substitute client is the same type as the subject client
+//   - The subject and substitute client states match in all parameters (expect frozen height, latest height and the client-chosen parameters)
+//
+// The latest consensus state of the substitute and its processed time and height are copied
+// into the subject client store, and the subject client is unfrozen.
 func (cs ClientState) CheckSubstituteAndUpdateState(
-	_ sdk.Context, _ codec.BinaryCodec, _, _ sdk.KVStore, _ exported.ClientState,
+	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
+	substituteClientStore sdk.KVStore, substituteClient exported.ClientState,
 ) error {
-	return sdkerrors.Wrapf(clienttypes.ErrInvalidSubstitute, "cannot substribute Mock client")
+	substituteClientState, ok := substituteClient.(*ClientState)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "expected type %T, got %T", &ClientState{}, substituteClient)
+	}
+
+	if !IsMatchingClientState(cs, *substituteClientState) {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidSubstitute, "subject client state does not match substitute client state")
+	}
+
+	if cs.Status(ctx, subjectClientStore, cdc) == exported.Frozen {
+		// unfreeze the client
+		cs.FrozenHeight = clienttypes.ZeroHeight()
+	}
+
+	// copy consensus state and processed time from substitute to subject
+	height := substituteClientState.GetLatestHeight()
+
+	consensusState, found := getConsensusState(substituteClientStore, cdc, height)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrConsensusStateNotFound, "unable to retrieve latest consensus state for substitute client")
+	}
+
+	setConsensusState(subjectClientStore, cdc, consensusState, height)
+
+	// set metadata stored for the substitute consensus state
+	processedHeight, found, err := getProcessedHeight(substituteClientStore, height)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "unable to retrieve processed height for substitute client latest height")
+	}
+
+	processedTime, found := getProcessedTime(substituteClientStore, height)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "unable to retrieve processed time for substitute client latest height")
+	}
+
+	setConsensusMetadataWithValues(subjectClientStore, height, processedHeight, processedTime)
+
+	cs.LatestHeight = substituteClientState.LatestHeight
+
+	// set the client-chosen parameters based on the substitute client state
+	copyClientParams(&cs, *substituteClientState)
+
+	// no validation is necessary since the substitute is verified to be Active
+	// in 02-client.
+	setClientState(subjectClientStore, cdc, &cs)
+
+	return nil
+}
+
+// IsMatchingClientState returns true if all the client state parameters match
+// except for frozen height, latest height and the client-chosen parameters.
+func IsMatchingClientState(subject, substitute ClientState) bool {
+	// zero out parameters which do not need to match
+	subject.LatestHeight = clienttypes.ZeroHeight()
+	subject.FrozenHeight = clienttypes.ZeroHeight()
+	zeroClientParams(&subject)
+	substitute.LatestHeight = clienttypes.ZeroHeight()
+	substitute.FrozenHeight = clienttypes.ZeroHeight()
+	zeroClientParams(&substitute)
+
+	return reflect.DeepEqual(subject, substitute)
 }
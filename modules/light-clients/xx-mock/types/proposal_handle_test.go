@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSubstituteAndUpdateState(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(substitute *ClientState)
+		expErr   error
+	}{
+		{"success", func(*ClientState) {}, nil},
+		{"mismatching upgrade path", func(substitute *ClientState) { substitute.UpgradePath = []string{"upgrade"} }, clienttypes.ErrInvalidSubstitute},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, store, cdc := newTestContext(t, time.Unix(0, 1000))
+			subjectClientStore := prefix.NewStore(store, []byte("subject/"))
+			substituteClientStore := prefix.NewStore(store, []byte("substitute/"))
+
+			subject := NewClientState(clienttypes.NewHeight(0, 10))
+			subject.TrustingPeriod = time.Nanosecond
+			subject.FrozenHeight = FrozenHeight
+			require.NoError(t, subject.Initialize(ctx, cdc, subjectClientStore, &ConsensusState{Timestamp: 1}))
+			require.Equal(t, exported.Frozen, subject.Status(ctx, subjectClientStore, cdc))
+
+			substitute := NewClientState(clienttypes.NewHeight(0, 20))
+			substitute.TrustingPeriod = time.Hour
+			tc.malleate(substitute)
+			require.NoError(t, substitute.Initialize(ctx, cdc, substituteClientStore, &ConsensusState{Timestamp: 900}))
+
+			err := subject.CheckSubstituteAndUpdateState(ctx, cdc, subjectClientStore, substituteClientStore, substitute)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Equal(t, exported.Frozen, getClientState(subjectClientStore, cdc).Status(ctx, subjectClientStore, cdc))
+				return
+			}
+			require.NoError(t, err)
+
+			cs := getClientState(subjectClientStore, cdc)
+			require.True(t, cs.FrozenHeight.IsZero())
+			require.Equal(t, substitute.LatestHeight, cs.LatestHeight)
+			require.Equal(t, substitute.TrustingPeriod, cs.TrustingPeriod)
+			require.Equal(t, exported.Active, cs.Status(ctx, subjectClientStore, cdc))
+
+			consensusState, found := getConsensusState(subjectClientStore, cdc, substitute.LatestHeight)
+			require.True(t, found)
+			require.Equal(t, uint64(900), consensusState.Timestamp)
+			_, found = getProcessedTime(subjectClientStore, substitute.LatestHeight)
+			require.True(t, found)
+		})
+	}
+}
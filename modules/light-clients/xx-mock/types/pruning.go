@@ -0,0 +1,108 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+)
+
+// Validate returns an error if the max age is negative.
+func (p PruningPolicy) Validate() error {
+	if p.MaxAge < 0 {
+		return sdkerrors.Wrapf(ErrInvalidPruningPolicy, "max age must be non-negative, got %s", p.MaxAge)
+	}
+	return nil
+}
+
+// IsEnabled returns whether the policy prunes any consensus state.
+func (p PruningPolicy) IsEnabled() bool {
+	return p.KeepLast > 0 || p.MaxAge > 0
+}
+
+// pruneConsensusStates deletes the consensus states which are not retained by the pruning policy, together with
+// their metadata, and returns the number of consensus states pruned.
+// Entries of the iteration index which cannot be read are skipped, so that pruning never fails an update.
+func (cs ClientState) pruneConsensusStates(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore) int {
+	if !cs.PruningPolicy.IsEnabled() {
+		return 0
+	}
+
+	var (
+		heights []clienttypes.Height
+		kept    uint64
+	)
+	iterateReadableConsensusStatesDescending(clientStore, cdc, func(height clienttypes.Height, consensusState *ConsensusState) bool {
+		if cs.isRetained(ctx, height, consensusState.Timestamp, kept) {
+			kept++
+		} else {
+			heights = append(heights, height)
+		}
+		return false
+	})
+
+	for _, height := range heights {
+		deleteConsensusState(clientStore, height)
+		deleteConsensusMetadata(clientStore, height)
+	}
+	return len(heights)
+}
+
+// retainsConsensusState returns whether the pruning policy would retain a consensus state with the given timestamp
+// at the given height, which is not stored yet.
+func (cs ClientState) retainsConsensusState(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
+	height clienttypes.Height, timestamp uint64,
+) bool {
+	if !cs.PruningPolicy.IsEnabled() {
+		return true
+	}
+
+	var kept uint64
+	iterateReadableConsensusStatesDescending(clientStore, cdc, func(h clienttypes.Height, consensusState *ConsensusState) bool {
+		if h.LTE(height) {
+			return true
+		}
+		if cs.isRetained(ctx, h, consensusState.Timestamp, kept) {
+			kept++
+		}
+		return false
+	})
+	return cs.isRetained(ctx, height, timestamp, kept)
+}
+
+// isRetained returns whether the pruning policy retains the consensus state with the given timestamp at the given
+// height, where kept is the number of consensus states retained at higher heights.
+// The consensus state at the latest height is always retained.
+func (cs ClientState) isRetained(ctx sdk.Context, height clienttypes.Height, timestamp uint64, kept uint64) bool {
+	if height.EQ(cs.LatestHeight) {
+		return true
+	}
+	if cs.PruningPolicy.KeepLast > 0 && kept >= cs.PruningPolicy.KeepLast {
+		return false
+	}
+	if cs.PruningPolicy.MaxAge > 0 && time.Unix(0, int64(timestamp)).Add(cs.PruningPolicy.MaxAge).Before(ctx.BlockTime()) {
+		return false
+	}
+	return true
+}
+
+// iterateReadableConsensusStatesDescending iterates through the consensus states in the client store in descending
+// order of their heights like iterateConsensusStatesDescending, but skips the entries of the iteration index which
+// cannot be read instead of failing.
+func iterateReadableConsensusStatesDescending(store sdk.KVStore, cdc codec.BinaryCodec, cb func(height clienttypes.Height, consensusState *ConsensusState) bool) {
+	iterator := prefix.NewStore(store, []byte(KeyIterateConsensusStatePrefix)).ReverseIterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		height, consensusState, err := readIndexedConsensusState(store, cdc, iterator.Key(), iterator.Value())
+		if err != nil {
+			continue
+		}
+		if cb(height, consensusState) {
+			break
+		}
+	}
+}
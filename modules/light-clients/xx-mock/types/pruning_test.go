@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruningPolicyValidate(t *testing.T) {
+	require.NoError(t, PruningPolicy{}.Validate())
+	require.NoError(t, PruningPolicy{KeepLast: 1, MaxAge: time.Hour}.Validate())
+	require.ErrorIs(t, PruningPolicy{MaxAge: -time.Hour}.Validate(), ErrInvalidPruningPolicy)
+}
+
+func TestUpdateStatePrunesConsensusStates(t *testing.T) {
+	blockTime := time.Unix(0, 50)
+
+	testCases := []struct {
+		name      string
+		policy    PruningPolicy
+		expHeight []uint64
+		expStored bool // whether the consensus state at the past height 5 is stored
+	}{
+		{"disabled", PruningPolicy{}, []uint64{1, 5, 10, 20, 30}, true},
+		{"keep last", PruningPolicy{KeepLast: 2}, []uint64{20, 30}, false},
+		{"max age", PruningPolicy{MaxAge: 25}, []uint64{30}, false},
+		{"keep last and max age", PruningPolicy{KeepLast: 3, MaxAge: 35}, []uint64{20, 30}, false},
+		{"latest height is never pruned", PruningPolicy{KeepLast: 1, MaxAge: 1}, []uint64{30}, false},
+		{"past height within the window", PruningPolicy{KeepLast: 5}, []uint64{1, 5, 10, 20, 30}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, clientStore, cdc := newTestContext(t, blockTime)
+			cs := NewClientState(clienttypes.NewHeight(0, 1))
+			cs.PruningPolicy = tc.policy
+			storeConsensusStates(ctx, clientStore, cdc, map[uint64]uint64{1: 1})
+
+			var heights []exported.Height
+			for _, height := range []uint64{10, 20, 30, 5} {
+				heights = cs.UpdateState(ctx, cdc, clientStore, &Header{Height: clienttypes.NewHeight(0, height), Timestamp: height})
+				cs = getClientState(clientStore, cdc)
+			}
+			// the past height is neither stored nor returned if it is outside of the retention window
+			require.Equal(t, tc.expStored, len(heights) == 1)
+			_, found := getConsensusState(clientStore, cdc, clienttypes.NewHeight(0, 5))
+			require.Equal(t, tc.expStored, found)
+
+			var stored []uint64
+			require.NoError(t, iterateConsensusStates(clientStore, cdc, func(h clienttypes.Height, _ *ConsensusState) bool {
+				stored = append(stored, h.RevisionHeight)
+				return false
+			}))
+			require.Equal(t, tc.expHeight, stored)
+
+			// the metadata of the pruned consensus states are deleted together with them
+			require.Len(t, cs.ExportMetadata(clientStore), 3*len(tc.expHeight))
+			for _, height := range []uint64{1, 5, 10, 20, 30} {
+				h := clienttypes.NewHeight(0, height)
+				_, found := getProcessedTime(clientStore, h)
+				require.Equal(t, clientStore.Has(host.ConsensusStateKey(h)), found)
+			}
+		})
+	}
+}
+
+func TestPruneConsensusStatesSkipsUnreadableEntries(t *testing.T) {
+	ctx, clientStore, cdc := newTestContext(t, time.Unix(0, 50))
+	cs := NewClientState(clienttypes.NewHeight(0, 30))
+	cs.PruningPolicy = PruningPolicy{KeepLast: 1}
+	storeConsensusStates(ctx, clientStore, cdc, map[uint64]uint64{10: 10, 20: 20, 30: 30})
+
+	// an index entry with a malformed key and one referencing a missing consensus state
+	clientStore.Set([]byte(KeyIterateConsensusStatePrefix+"malformed"), host.ConsensusStateKey(clienttypes.NewHeight(0, 25)))
+	setIterationKey(clientStore, clienttypes.NewHeight(0, 15))
+
+	require.NotPanics(t, func() {
+		cs.UpdateState(ctx, cdc, clientStore, &Header{Height: clienttypes.NewHeight(0, 40), Timestamp: 40})
+	})
+	for _, height := range []uint64{10, 20, 30} {
+		_, found := getConsensusState(clientStore, cdc, clienttypes.NewHeight(0, height))
+		require.False(t, found, height)
+	}
+	_, found := getConsensusState(clientStore, cdc, clienttypes.NewHeight(0, 40))
+	require.True(t, found)
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// Validate returns an error if the status is unknown or the transition has no trigger.
+func (t StatusTransition) Validate() error {
+	if _, ok := ScheduledStatus_name[int32(t.Status)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidStatusSchedule, "unknown scheduled status: %d", t.Status)
+	}
+	if t.SelfHeight.IsZero() && t.BlockTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidStatusSchedule, "either self height or block time must be set")
+	}
+	return nil
+}
+
+// IsTriggered returns whether the trigger of the transition has been reached at the given context.
+func (t StatusTransition) IsTriggered(ctx sdk.Context) bool {
+	if !t.SelfHeight.IsZero() && clienttypes.GetSelfHeight(ctx).LT(t.SelfHeight) {
+		return false
+	}
+	if !t.BlockTime.IsZero() && ctx.BlockTime().Before(t.BlockTime) {
+		return false
+	}
+	return true
+}
+
+// ExportedStatus returns the client status corresponding to the scheduled status.
+func (s ScheduledStatus) ExportedStatus() exported.Status {
+	switch s {
+	case ScheduledStatusActive:
+		return exported.Active
+	case ScheduledStatusFrozen:
+		return exported.Frozen
+	case ScheduledStatusExpired:
+		return exported.Expired
+	default:
+		return exported.Unknown
+	}
+}
+
+// scheduledStatus returns the status of the last transition of the schedule whose trigger has been reached,
+// or false if there is no such transition.
+func (cs ClientState) scheduledStatus(ctx sdk.Context) (exported.Status, bool) {
+	for i := len(cs.StatusSchedule) - 1; i >= 0; i-- {
+		if t := cs.StatusSchedule[i]; t.IsTriggered(ctx) {
+			return t.Status.ExportedStatus(), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusTransitionValidate(t *testing.T) {
+	require.NoError(t, StatusTransition{Status: ScheduledStatusFrozen, SelfHeight: clienttypes.NewHeight(0, 1)}.Validate())
+	require.NoError(t, StatusTransition{Status: ScheduledStatusExpired, BlockTime: time.Unix(1, 0)}.Validate())
+	require.ErrorIs(t, StatusTransition{Status: ScheduledStatusFrozen}.Validate(), ErrInvalidStatusSchedule)
+	require.ErrorIs(t, StatusTransition{Status: ScheduledStatus(100), BlockTime: time.Unix(1, 0)}.Validate(), ErrInvalidStatusSchedule)
+}
+
+func TestStatusSchedule(t *testing.T) {
+	schedule := []StatusTransition{
+		{Status: ScheduledStatusFrozen, SelfHeight: clienttypes.NewHeight(0, 10)},
+		{Status: ScheduledStatusActive, SelfHeight: clienttypes.NewHeight(0, 20), BlockTime: time.Unix(200, 0)},
+		{Status: ScheduledStatusExpired, BlockTime: time.Unix(300, 0)},
+	}
+
+	testCases := []struct {
+		name       string
+		selfHeight int64
+		blockTime  int64
+		frozen     bool
+		expStatus  exported.Status
+	}{
+		{"before the schedule", 9, 100, false, exported.Active},
+		{"at the self height of the first transition", 10, 100, false, exported.Frozen},
+		{"self height of the second transition without its block time", 20, 199, false, exported.Frozen},
+		{"both triggers of the second transition", 20, 200, false, exported.Active},
+		{"block time of the last transition", 20, 300, false, exported.Expired},
+		{"frozen client before the schedule", 9, 100, true, exported.Frozen},
+		{"frozen client after the schedule", 20, 200, true, exported.Frozen},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, clientStore, cdc := newTestContext(t, time.Unix(tc.blockTime, 0))
+			ctx = ctx.WithBlockHeight(tc.selfHeight)
+
+			cs := NewClientState(clienttypes.NewHeight(0, 1))
+			cs.StatusSchedule = schedule
+			if tc.frozen {
+				cs.FrozenHeight = FrozenHeight
+			}
+			require.NoError(t, cs.Validate())
+			require.NoError(t, cs.Initialize(ctx, cdc, clientStore, &ConsensusState{Timestamp: 1}))
+			require.Equal(t, tc.expStatus, cs.Status(ctx, clientStore, cdc))
+		})
+	}
+}
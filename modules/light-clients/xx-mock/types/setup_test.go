@@ -0,0 +1,71 @@
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// newTestContext returns a context at the given block time, a client store and a codec with the Mock types
+// and the public keys registered.
+func newTestContext(t *testing.T, blockTime time.Time) (sdk.Context, sdk.KVStore, codec.BinaryCodec) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(ModuleName)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).WithBlockTime(blockTime)
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	RegisterInterfaces(registry)
+	return ctx, ctx.KVStore(key), codec.NewProtoCodec(registry)
+}
+
+// storeConsensusStates stores a consensus state with the given timestamp and its metadata at each height.
+func storeConsensusStates(ctx sdk.Context, clientStore sdk.KVStore, cdc codec.BinaryCodec, timestamps map[uint64]uint64) {
+	for height, timestamp := range timestamps {
+		h := clienttypes.NewHeight(0, height)
+		setConsensusState(clientStore, cdc, &ConsensusState{Timestamp: timestamp}, h)
+		setConsensusMetadata(ctx, clientStore, h)
+	}
+}
+
+// getClientState returns the client state stored in the client store.
+func getClientState(clientStore sdk.KVStore, cdc codec.BinaryCodec) *ClientState {
+	return clienttypes.MustUnmarshalClientState(cdc, clientStore.Get(host.ClientStateKey())).(*ClientState)
+}
+
+// testClientKeeper is a ClientKeeper over a single client store.
+type testClientKeeper struct {
+	clientID    string
+	clientState exported.ClientState
+	clientStore sdk.KVStore
+}
+
+var _ ClientKeeper = testClientKeeper{}
+
+func (k testClientKeeper) GetClientState(_ sdk.Context, clientID string) (exported.ClientState, bool) {
+	if clientID != k.clientID {
+		return nil, false
+	}
+	return k.clientState, true
+}
+
+func (k testClientKeeper) IterateClientStates(_ sdk.Context, prefix []byte, cb func(clientID string, cs exported.ClientState) bool) {
+	if strings.HasPrefix(k.clientID, string(prefix)) {
+		cb(k.clientID, k.clientState)
+	}
+}
+
+func (k testClientKeeper) ClientStore(sdk.Context, string) sdk.KVStore {
+	return k.clientStore
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// unpackAuthorityPubKey returns the public key cached in the given Any.
+// An error is returned if the cached value is not a supported authority public key.
+func unpackAuthorityPubKey(any *codectypes.Any) (cryptotypes.PubKey, error) {
+	publicKey, ok := any.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidPublicKey, "public key is not cryptotypes.PubKey")
+	}
+	if err := validateAuthorityPubKey(publicKey); err != nil {
+		return nil, err
+	}
+	return publicKey, nil
+}
+
+// validateAuthorityPubKey returns an error if the public key is neither a single ed25519 or secp256k1 key
+// nor a threshold multisig key composed of such keys.
+func validateAuthorityPubKey(publicKey cryptotypes.PubKey) error {
+	switch publicKey := publicKey.(type) {
+	case *ed25519.PubKey, *secp256k1.PubKey:
+		return nil
+	case *multisig.LegacyAminoPubKey:
+		signers := publicKey.GetPubKeys()
+		if publicKey.Threshold == 0 || int(publicKey.Threshold) > len(signers) {
+			return sdkerrors.Wrapf(ErrInvalidPublicKey, "threshold must be between 1 and the number of signers %d, got %d", len(signers), publicKey.Threshold)
+		}
+		for i, signer := range signers {
+			switch signer.(type) {
+			case *ed25519.PubKey, *secp256k1.PubKey:
+			default:
+				return sdkerrors.Wrapf(ErrInvalidPublicKey, "signer %d must be either ed25519 or secp256k1, got %T", i, signer)
+			}
+		}
+		return nil
+	default:
+		return sdkerrors.Wrapf(ErrInvalidPublicKey, "public key must be either ed25519, secp256k1 or multisig, got %T", publicKey)
+	}
+}
+
+// verifySignature verifies the signature of the header with the authority public key.
+// Headers are not required to be signed if the client has no authority public key.
+func (cs *ClientState) verifySignature(header *Header) error {
+	publicKey, err := cs.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if publicKey == nil {
+		if header.NewPublicKey != nil {
+			return sdkerrors.Wrapf(ErrInvalidPublicKey, "header at height %s cannot rotate the authority of a client accepting unsigned headers", header.Height)
+		}
+		return nil
+	}
+
+	if header.NewPublicKey != nil {
+		if _, err := unpackAuthorityPubKey(header.NewPublicKey); err != nil {
+			return sdkerrors.Wrapf(err, "invalid new authority in header at height %s", header.Height)
+		}
+	}
+
+	if len(header.Signature) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidSignature, "header at height %s is not signed", header.Height)
+	}
+
+	signBytes, err := header.GetSignBytes()
+	if err != nil {
+		return err
+	}
+
+	if multisigKey, ok := publicKey.(*multisig.LegacyAminoPubKey); ok {
+		return verifyMultiSignature(multisigKey, signBytes, header)
+	}
+
+	if !publicKey.VerifySignature(signBytes, header.Signature) {
+		return sdkerrors.Wrapf(ErrInvalidSignature, "signature of header at height %s does not verify with the authority public key", header.Height)
+	}
+	return nil
+}
+
+// verifyMultiSignature verifies that at least threshold signers of the multisig key signed the header.
+// The signatures of the header are ordered as the signers of the key, with an empty signature for each absent signer.
+func verifyMultiSignature(publicKey *multisig.LegacyAminoPubKey, signBytes []byte, header *Header) error {
+	var multiSignature cryptotypes.MultiSignature
+	if err := multiSignature.Unmarshal(header.Signature); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidSignature, "failed to decode multisignature of header at height %s: %v", header.Height, err)
+	}
+
+	signers := publicKey.GetPubKeys()
+	if len(multiSignature.Signatures) != len(signers) {
+		return sdkerrors.Wrapf(
+			ErrInvalidSignature,
+			"multisignature of header at height %s must contain %d signatures, got %d",
+			header.Height, len(signers), len(multiSignature.Signatures),
+		)
+	}
+
+	var missing []string
+	for i, signer := range signers {
+		signature := multiSignature.Signatures[i]
+		if len(signature) == 0 || !signer.VerifySignature(signBytes, signature) {
+			missing = append(missing, fmt.Sprintf("%d:%s", i, signer.Address()))
+		}
+	}
+
+	if signed := len(signers) - len(missing); signed < int(publicKey.Threshold) {
+		return sdkerrors.Wrapf(
+			ErrInsufficientSignatures,
+			"header at height %s is signed by %d of %d signers, threshold is %d, missing signers: [%s]",
+			header.Height, signed, len(signers), publicKey.Threshold, strings.Join(missing, ", "),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+// newPubKeyAny packs the public key into an Any with the key cached.
+func newPubKeyAny(t *testing.T, publicKey cryptotypes.PubKey) *codectypes.Any {
+	t.Helper()
+
+	any, err := codectypes.NewAnyWithValue(publicKey)
+	require.NoError(t, err)
+	return any
+}
+
+// signHeader sets the signature of the header by the private key.
+func signHeader(t *testing.T, privKey cryptotypes.PrivKey, header *Header) {
+	t.Helper()
+
+	signBytes, err := header.GetSignBytes()
+	require.NoError(t, err)
+	header.Signature, err = privKey.Sign(signBytes)
+	require.NoError(t, err)
+}
+
+func TestVerifySignature(t *testing.T) {
+	for _, privKey := range []cryptotypes.PrivKey{ed25519.GenPrivKey(), secp256k1.GenPrivKey()} {
+		privKey := privKey
+		t.Run(privKey.Type(), func(t *testing.T) {
+			cs := &ClientState{LatestHeight: clienttypes.NewHeight(0, 1), PublicKey: newPubKeyAny(t, privKey.PubKey())}
+
+			header := &Header{Height: clienttypes.NewHeight(0, 2), Timestamp: 2}
+			require.ErrorIs(t, cs.verifySignature(header), ErrInvalidSignature, "unsigned header")
+
+			signHeader(t, privKey, header)
+			require.NoError(t, cs.verifySignature(header))
+
+			header.Timestamp = 3
+			require.ErrorIs(t, cs.verifySignature(header), ErrInvalidSignature, "header modified after signing")
+
+			otherHeader := &Header{Height: clienttypes.NewHeight(0, 2), Timestamp: 2}
+			signHeader(t, ed25519.GenPrivKey(), otherHeader)
+			require.ErrorIs(t, cs.verifySignature(otherHeader), ErrInvalidSignature, "header signed by another key")
+		})
+	}
+}
+
+func TestVerifySignatureWithoutAuthority(t *testing.T) {
+	cs := &ClientState{LatestHeight: clienttypes.NewHeight(0, 1)}
+	require.NoError(t, cs.verifySignature(&Header{Height: clienttypes.NewHeight(0, 2), Timestamp: 2}))
+}
+
+// multiSignHeader sets the multisignature of the header by the private keys, leaving the signature empty for nil keys.
+func multiSignHeader(t *testing.T, privKeys []cryptotypes.PrivKey, header *Header) {
+	t.Helper()
+
+	signBytes, err := header.GetSignBytes()
+	require.NoError(t, err)
+	multiSignature := cryptotypes.MultiSignature{Signatures: make([][]byte, len(privKeys))}
+	for i, privKey := range privKeys {
+		if privKey == nil {
+			continue
+		}
+		multiSignature.Signatures[i], err = privKey.Sign(signBytes)
+		require.NoError(t, err)
+	}
+	header.Signature, err = multiSignature.Marshal()
+	require.NoError(t, err)
+}
+
+func TestVerifyMultiSignature(t *testing.T) {
+	privKeys := []cryptotypes.PrivKey{ed25519.GenPrivKey(), secp256k1.GenPrivKey(), ed25519.GenPrivKey()}
+	publicKey := multisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{privKeys[0].PubKey(), privKeys[1].PubKey(), privKeys[2].PubKey()})
+	cs := &ClientState{LatestHeight: clienttypes.NewHeight(0, 1), PublicKey: newPubKeyAny(t, publicKey)}
+
+	header := &Header{Height: clienttypes.NewHeight(0, 2), Timestamp: 2}
+	multiSignHeader(t, []cryptotypes.PrivKey{privKeys[0], nil, privKeys[2]}, header)
+	require.NoError(t, cs.verifySignature(header), "threshold reached")
+
+	multiSignHeader(t, privKeys, header)
+	require.NoError(t, cs.verifySignature(header), "all signers")
+
+	multiSignHeader(t, []cryptotypes.PrivKey{nil, privKeys[1], nil}, header)
+	err := cs.verifySignature(header)
+	require.ErrorIs(t, err, ErrInsufficientSignatures)
+	require.Contains(t, err.Error(), "signed by 1 of 3 signers, threshold is 2")
+	require.Contains(t, err.Error(), "missing signers: [0:"+privKeys[0].PubKey().Address().String()+", 2:"+privKeys[2].PubKey().Address().String()+"]")
+
+	multiSignHeader(t, []cryptotypes.PrivKey{privKeys[0], privKeys[0], nil}, header)
+	err = cs.verifySignature(header)
+	require.ErrorIs(t, err, ErrInsufficientSignatures, "signature by another signer")
+	require.Contains(t, err.Error(), "missing signers: [1:"+privKeys[1].PubKey().Address().String()+", 2:"+privKeys[2].PubKey().Address().String()+"]")
+
+	multiSignHeader(t, privKeys[:2], header)
+	require.ErrorIs(t, cs.verifySignature(header), ErrInvalidSignature, "wrong number of signatures")
+}
+
+func TestRotateAuthority(t *testing.T) {
+	ctx, clientStore, cdc := newTestContext(t, time.Unix(0, 1000))
+	oldKey, newKey, nextKey := ed25519.GenPrivKey(), secp256k1.GenPrivKey(), ed25519.GenPrivKey()
+
+	cs := &ClientState{LatestHeight: clienttypes.NewHeight(0, 1), PublicKey: newPubKeyAny(t, oldKey.PubKey())}
+	header := &Header{Height: clienttypes.NewHeight(0, 2), Timestamp: 2, NewPublicKey: newPubKeyAny(t, newKey.PubKey())}
+	signHeader(t, newKey, header)
+	require.ErrorIs(t, cs.VerifyClientMessage(ctx, cdc, clientStore, header), ErrInvalidSignature, "rotation signed by the new key")
+
+	signHeader(t, oldKey, header)
+	require.NoError(t, cs.VerifyClientMessage(ctx, cdc, clientStore, header))
+	cs.UpdateState(ctx, cdc, clientStore, header)
+	cs = getClientState(clientStore, cdc)
+	publicKey, err := cs.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, publicKey.Equals(newKey.PubKey()))
+
+	header = &Header{Height: clienttypes.NewHeight(0, 3), Timestamp: 3}
+	signHeader(t, oldKey, header)
+	require.ErrorIs(t, cs.VerifyClientMessage(ctx, cdc, clientStore, header), ErrInvalidSignature, "header signed by the rotated key")
+	signHeader(t, newKey, header)
+	require.NoError(t, cs.VerifyClientMessage(ctx, cdc, clientStore, header))
+
+	// a header which duplicates a stored consensus state still rotates the authority
+	header = &Header{Height: clienttypes.NewHeight(0, 2), Timestamp: 2, NewPublicKey: newPubKeyAny(t, nextKey.PubKey())}
+	signHeader(t, newKey, header)
+	require.NoError(t, cs.VerifyClientMessage(ctx, cdc, clientStore, header))
+	require.False(t, cs.CheckForMisbehaviour(ctx, cdc, clientStore, header))
+	cs.UpdateState(ctx, cdc, clientStore, header)
+	cs = getClientState(clientStore, cdc)
+	publicKey, err = cs.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, publicKey.Equals(nextKey.PubKey()))
+}
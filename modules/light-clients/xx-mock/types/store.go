@@ -1,13 +1,25 @@
 package types
 
 import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
+// KeyIterateConsensusStatePrefix is the prefix of the iteration keys, under which the consensus state keys are
+// stored in the order of their heights
+const KeyIterateConsensusStatePrefix = "iterateConsensusStates"
+
 var (
 	// keyProcessedTime is appended to consensus state key to store the processed time
 	keyProcessedTime = []byte("/processedTime")
@@ -22,11 +34,18 @@ func setClientState(clientStore sdk.KVStore, cdc codec.BinaryCodec, clientState
 	clientStore.Set(key, val)
 }
 
-// setConsensusState stores the consensus state at the given height.
+// setConsensusState stores the consensus state at the given height and indexes it for ordered iteration.
 func setConsensusState(clientStore sdk.KVStore, cdc codec.BinaryCodec, consensusState *ConsensusState, height exported.Height) {
 	key := host.ConsensusStateKey(height)
 	val := clienttypes.MustMarshalConsensusState(cdc, consensusState)
 	clientStore.Set(key, val)
+	setIterationKey(clientStore, height)
+}
+
+// deleteConsensusState deletes the consensus state at the given height together with its iteration key.
+func deleteConsensusState(clientStore sdk.KVStore, height exported.Height) {
+	clientStore.Delete(host.ConsensusStateKey(height))
+	clientStore.Delete(IterationKey(height))
 }
 
 // getConsensusState retrieves the consensus state from the client prefixed store.
@@ -41,8 +60,239 @@ func getConsensusState(store sdk.KVStore, cdc codec.BinaryCodec, height exported
 	return consensusStateI.(*ConsensusState), true
 }
 
-// processedTimeKey returns the key under which the processed time will be stored in the client store.
-func processedTimeKey(height exported.Height) []byte {
+// IterationKey returns the key under which the consensus state key is stored in the iteration index.
+// The iteration key is a big-endian representation of the height, so that the consensus states are
+// iterated in the order of their heights.
+func IterationKey(height exported.Height) []byte {
+	return append([]byte(KeyIterateConsensusStatePrefix), bigEndianHeightBytes(height)...)
+}
+
+// setIterationKey stores the consensus state key under the iteration key of the height.
+func setIterationKey(clientStore sdk.KVStore, height exported.Height) {
+	clientStore.Set(IterationKey(height), host.ConsensusStateKey(height))
+}
+
+// parseIterationKey returns the height referenced by the iteration key without the prefix.
+func parseIterationKey(key []byte) (clienttypes.Height, error) {
+	if len(key) != 16 {
+		return clienttypes.Height{}, sdkerrors.Wrapf(ErrInvalidIterationKey, "iteration key must be 16 bytes, got %d bytes", len(key))
+	}
+	return heightFromBigEndianBytes(key), nil
+}
+
+// heightFromBigEndianBytes decodes the 16-byte height encoded by bigEndianHeightBytes.
+func heightFromBigEndianBytes(bz []byte) clienttypes.Height {
+	return clienttypes.NewHeight(binary.BigEndian.Uint64(bz[:8]), binary.BigEndian.Uint64(bz[8:]))
+}
+
+// bigEndianHeightBytes returns the 16-byte big-endian encoding of the revision number and the revision height.
+func bigEndianHeightBytes(height exported.Height) []byte {
+	heightBytes := make([]byte, 16)
+	binary.BigEndian.PutUint64(heightBytes, height.GetRevisionNumber())
+	binary.BigEndian.PutUint64(heightBytes[8:], height.GetRevisionHeight())
+	return heightBytes
+}
+
+// readConsensusState returns the consensus state stored under the given key, or an error if it is missing
+// or is not a Mock consensus state.
+func readConsensusState(store sdk.KVStore, cdc codec.BinaryCodec, consStateKey []byte) (*ConsensusState, error) {
+	bz := store.Get(consStateKey)
+	if len(bz) == 0 {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "key: %s", consStateKey)
+	}
+	consensusState, err := clienttypes.UnmarshalConsensusState(cdc, bz)
+	if err != nil {
+		return nil, err
+	}
+	mockConsensusState, ok := consensusState.(*ConsensusState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "expected consensus state type %T, got %T", &ConsensusState{}, consensusState)
+	}
+	return mockConsensusState, nil
+}
+
+// readIndexedConsensusState returns the height and the consensus state referenced by an entry of the iteration index,
+// whose key is given without the prefix.
+func readIndexedConsensusState(store sdk.KVStore, cdc codec.BinaryCodec, key, consStateKey []byte) (clienttypes.Height, *ConsensusState, error) {
+	height, err := parseIterationKey(key)
+	if err != nil {
+		return clienttypes.Height{}, nil, err
+	}
+	consensusState, err := readConsensusState(store, cdc, consStateKey)
+	if err != nil {
+		return clienttypes.Height{}, nil, sdkerrors.Wrapf(err, "indexed consensus state at height %s", height)
+	}
+	return height, consensusState, nil
+}
+
+// iterateConsensusStates iterates through all the consensus states in the client store in ascending order of
+// their heights and applies the callback on each of them.
+// If the cb returns true, then iterator will close and stop.
+func iterateConsensusStates(store sdk.KVStore, cdc codec.BinaryCodec, cb func(height clienttypes.Height, consensusState *ConsensusState) bool) error {
+	iterator := prefix.NewStore(store, []byte(KeyIterateConsensusStatePrefix)).Iterator(nil, nil)
+	return iterateIndex(store, cdc, iterator, cb)
+}
+
+// iterateConsensusStatesDescending iterates through all the consensus states in the client store in descending order of
+// their heights and applies the callback on each of them.
+// If the cb returns true, then iterator will close and stop.
+func iterateConsensusStatesDescending(store sdk.KVStore, cdc codec.BinaryCodec, cb func(height clienttypes.Height, consensusState *ConsensusState) bool) error {
+	iterator := prefix.NewStore(store, []byte(KeyIterateConsensusStatePrefix)).ReverseIterator(nil, nil)
+	return iterateIndex(store, cdc, iterator, cb)
+}
+
+// iterateIndex applies the callback on the consensus state referenced by each entry of the iteration index
+// until the iterator is exhausted or the cb returns true, and closes the iterator.
+func iterateIndex(store sdk.KVStore, cdc codec.BinaryCodec, iterator storetypes.Iterator, cb func(height clienttypes.Height, consensusState *ConsensusState) bool) error {
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		height, consensusState, err := readIndexedConsensusState(store, cdc, iterator.Key(), iterator.Value())
+		if err != nil {
+			return err
+		}
+		if cb(height, consensusState) {
+			break
+		}
+	}
+	return nil
+}
+
+// paginateConsensusStates applies the callback on the consensus states in the page of the iteration index
+// selected by the page request. The consensus states are paginated in ascending order of their heights,
+// or in descending order if the page request is reversed.
+func paginateConsensusStates(
+	store sdk.KVStore, cdc codec.BinaryCodec, pageReq *query.PageRequest,
+	cb func(height clienttypes.Height, consensusState *ConsensusState) error,
+) (*query.PageResponse, error) {
+	iterateStore := prefix.NewStore(store, []byte(KeyIterateConsensusStatePrefix))
+	return query.Paginate(iterateStore, pageReq, func(key, value []byte) error {
+		height, consensusState, err := readIndexedConsensusState(store, cdc, key, value)
+		if err != nil {
+			return err
+		}
+		return cb(height, consensusState)
+	})
+}
+
+// getPreviousConsensusState returns the consensus state at the highest height lower than the given height.
+func getPreviousConsensusState(store sdk.KVStore, cdc codec.BinaryCodec, height exported.Height) (clienttypes.Height, *ConsensusState, bool, error) {
+	iterateStore := prefix.NewStore(store, []byte(KeyIterateConsensusStatePrefix))
+	// the reverse iterator starts from the highest key lower than the end key (exclusive)
+	iterator := iterateStore.ReverseIterator(nil, bigEndianHeightBytes(height))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return clienttypes.Height{}, nil, false, nil
+	}
+	prevHeight, consensusState, err := readIndexedConsensusState(store, cdc, iterator.Key(), iterator.Value())
+	if err != nil {
+		return clienttypes.Height{}, nil, false, err
+	}
+	return prevHeight, consensusState, true, nil
+}
+
+// getNextConsensusState returns the consensus state at the lowest height greater than the given height.
+func getNextConsensusState(store sdk.KVStore, cdc codec.BinaryCodec, height exported.Height) (clienttypes.Height, *ConsensusState, bool, error) {
+	iterateStore := prefix.NewStore(store, []byte(KeyIterateConsensusStatePrefix))
+	// the iterator starts from the start key (inclusive), so the entry at the given height must be skipped
+	iterator := iterateStore.Iterator(bigEndianHeightBytes(height), nil)
+	defer iterator.Close()
+	if iterator.Valid() && bytes.Equal(iterator.Key(), bigEndianHeightBytes(height)) {
+		iterator.Next()
+	}
+	if !iterator.Valid() {
+		return clienttypes.Height{}, nil, false, nil
+	}
+	nextHeight, consensusState, err := readIndexedConsensusState(store, cdc, iterator.Key(), iterator.Value())
+	if err != nil {
+		return clienttypes.Height{}, nil, false, err
+	}
+	return nextHeight, consensusState, true, nil
+}
+
+// getConsensusStateAtOrBefore returns the consensus state at the given height, or the one at the highest height
+// lower than the given height if no consensus state exists at the given height.
+func getConsensusStateAtOrBefore(store sdk.KVStore, cdc codec.BinaryCodec, height exported.Height) (clienttypes.Height, *ConsensusState, bool, error) {
+	if store.Has(host.ConsensusStateKey(height)) {
+		consensusState, err := readConsensusState(store, cdc, host.ConsensusStateKey(height))
+		if err != nil {
+			return clienttypes.Height{}, nil, false, err
+		}
+		return clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()), consensusState, true, nil
+	}
+	return getPreviousConsensusState(store, cdc, height)
+}
+
+// getNeighbouringConsensusStates returns the consensus states at the highest height lower than the given height
+// and at the lowest height greater than the given height. A missing neighbour is returned as nil.
+func getNeighbouringConsensusStates(store sdk.KVStore, cdc codec.BinaryCodec, height exported.Height) (prev, next *ConsensusState, err error) {
+	if _, prev, _, err = getPreviousConsensusState(store, cdc, height); err != nil {
+		return nil, nil, err
+	}
+	if _, next, _, err = getNextConsensusState(store, cdc, height); err != nil {
+		return nil, nil, err
+	}
+	return prev, next, nil
+}
+
+// newConsensusStateWithMetadata returns the given consensus state together with its processed time and height.
+// A missing processed time or height is left as zero.
+func newConsensusStateWithMetadata(store sdk.KVStore, height clienttypes.Height, consensusState *ConsensusState) (*ConsensusStateWithMetadata, error) {
+	entry := &ConsensusStateWithMetadata{
+		Height:         height,
+		ConsensusState: *consensusState,
+	}
+	if processedTime, found := getProcessedTime(store, height); found {
+		entry.ProcessedTime = processedTime
+	}
+	processedHeight, found, err := getProcessedHeight(store, height)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		entry.ProcessedHeight = clienttypes.NewHeight(processedHeight.GetRevisionNumber(), processedHeight.GetRevisionHeight())
+	}
+	return entry, nil
+}
+
+// IterateConsensusMetadata iterates through the prefix store and applies the callback on every
+// processed time and processed height entry, and then on every iteration key.
+// If the cb returns true, then iterator will close and stop.
+func IterateConsensusMetadata(store sdk.KVStore, cb func(key, val []byte) bool) {
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyConsensusStatePrefix))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+		// processed time key in prefix store has format: "consensusStates/<height>/processedTime"
+		if len(keySplit) != 3 {
+			// ignore all consensus state keys
+			continue
+		}
+
+		suffix := []byte("/" + keySplit[2])
+		if !bytes.Equal(suffix, keyProcessedTime) && !bytes.Equal(suffix, keyProcessedHeight) {
+			// only perform callback on consensus metadata
+			continue
+		}
+
+		if cb(iterator.Key(), iterator.Value()) {
+			return
+		}
+	}
+
+	// iterate over iteration keys
+	iter := sdk.KVStorePrefixIterator(store, []byte(KeyIterateConsensusStatePrefix))
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key(), iter.Value()) {
+			break
+		}
+	}
+}
+
+// ProcessedTimeKey returns the key under which the processed time will be stored in the client store.
+func ProcessedTimeKey(height exported.Height) []byte {
 	return append(host.ConsensusStateKey(height), keyProcessedTime...)
 }
 
@@ -50,7 +300,7 @@ func processedTimeKey(height exported.Height) []byte {
 // This is useful when validating whether a packet has reached the time specified delay period in the tendermint client's
 // verification functions
 func setProcessedTime(clientStore sdk.KVStore, height exported.Height, timeNs uint64) {
-	key := processedTimeKey(height)
+	key := ProcessedTimeKey(height)
 	val := sdk.Uint64ToBigEndian(timeNs)
 	clientStore.Set(key, val)
 }
@@ -58,7 +308,7 @@ func setProcessedTime(clientStore sdk.KVStore, height exported.Height, timeNs ui
 // getProcessedTime gets the time (in nanoseconds) at which this chain received and processed a tendermint header.
 // This is used to validate that a received packet has passed the time delay period.
 func getProcessedTime(clientStore sdk.KVStore, height exported.Height) (uint64, bool) {
-	key := processedTimeKey(height)
+	key := ProcessedTimeKey(height)
 	bz := clientStore.Get(key)
 	if len(bz) == 0 {
 		return 0, false
@@ -66,8 +316,13 @@ func getProcessedTime(clientStore sdk.KVStore, height exported.Height) (uint64,
 	return sdk.BigEndianToUint64(bz), true
 }
 
-// processedHeightKey returns the key under which the processed height will be stored in the client store.
-func processedHeightKey(height exported.Height) []byte {
+// deleteProcessedTime deletes the processed time at the given height.
+func deleteProcessedTime(clientStore sdk.KVStore, height exported.Height) {
+	clientStore.Delete(ProcessedTimeKey(height))
+}
+
+// ProcessedHeightKey returns the key under which the processed height will be stored in the client store.
+func ProcessedHeightKey(height exported.Height) []byte {
 	return append(host.ConsensusStateKey(height), keyProcessedHeight...)
 }
 
@@ -75,24 +330,58 @@ func processedHeightKey(height exported.Height) []byte {
 // This is useful when validating whether a packet has reached the specified block delay period in the tendermint client's
 // verification functions
 func setProcessedHeight(clientStore sdk.KVStore, consHeight, processedHeight exported.Height) {
-	key := processedHeightKey(consHeight)
-	val := []byte(processedHeight.String())
+	key := ProcessedHeightKey(consHeight)
+	val := bigEndianHeightBytes(processedHeight)
 	clientStore.Set(key, val)
 }
 
 // getProcessedHeight gets the height at which this chain received and processed a tendermint header.
 // This is used to validate that a received packet has passed the block delay period.
-func getProcessedHeight(clientStore sdk.KVStore, height exported.Height) (exported.Height, bool) {
-	key := processedHeightKey(height)
+// It returns an error if the stored processed height cannot be parsed.
+func getProcessedHeight(clientStore sdk.KVStore, height exported.Height) (exported.Height, bool, error) {
+	key := ProcessedHeightKey(height)
 	bz := clientStore.Get(key)
 	if len(bz) == 0 {
-		return nil, false
+		return nil, false, nil
 	}
-	processedHeight, err := clienttypes.ParseHeight(string(bz))
+	processedHeight, err := parseProcessedHeight(bz)
 	if err != nil {
-		return nil, false
+		return nil, false, sdkerrors.Wrapf(err, "height: %s", height)
 	}
-	return processedHeight, true
+	return processedHeight, true, nil
+}
+
+// deleteProcessedHeight deletes the processed height at the given height.
+func deleteProcessedHeight(clientStore sdk.KVStore, height exported.Height) {
+	clientStore.Delete(ProcessedHeightKey(height))
+}
+
+// parseProcessedHeight parses the processed height stored in the client store, which is either in the 16-byte
+// big-endian encoding or in the legacy string encoding written before the binary encoding was introduced.
+func parseProcessedHeight(bz []byte) (clienttypes.Height, error) {
+	if isLegacyProcessedHeight(bz) {
+		height, err := clienttypes.ParseHeight(string(bz))
+		if err != nil {
+			return clienttypes.Height{}, sdkerrors.Wrapf(ErrInvalidProcessedHeight, "failed to parse legacy processed height '%s': %v", bz, err)
+		}
+		return height, nil
+	}
+	if len(bz) != 16 {
+		return clienttypes.Height{}, sdkerrors.Wrapf(ErrInvalidProcessedHeight, "processed height must be 16 bytes, got %d bytes", len(bz))
+	}
+	return heightFromBigEndianBytes(bz), nil
+}
+
+// isLegacyProcessedHeight returns whether the processed height is in the legacy "{revision number}-{revision height}"
+// string encoding. The binary encoding consists only of digits and dashes only if the revision number is at least
+// 0x2d << 56, so it is never mistaken for the legacy encoding in practice.
+func isLegacyProcessedHeight(bz []byte) bool {
+	for _, b := range bz {
+		if (b < '0' || b > '9') && b != '-' {
+			return false
+		}
+	}
+	return len(bz) > 0
 }
 
 // setConsensusMetadata sets context time as processed time and set context height as processed height.
@@ -110,3 +399,9 @@ func setConsensusMetadataWithValues(
 	setProcessedTime(clientStore, height, processedTime)
 	setProcessedHeight(clientStore, height, processedHeight)
 }
+
+// deleteConsensusMetadata deletes the processed time and height at the given height.
+func deleteConsensusMetadata(clientStore sdk.KVStore, height exported.Height) {
+	deleteProcessedTime(clientStore, height)
+	deleteProcessedHeight(clientStore, height)
+}
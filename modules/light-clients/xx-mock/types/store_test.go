@@ -0,0 +1,198 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/stretchr/testify/require"
+)
+
+// heights are the heights of the consensus states stored in the tests, which are not in the lexicographic order.
+var heights = []clienttypes.Height{
+	clienttypes.NewHeight(0, 100),
+	clienttypes.NewHeight(1, 2),
+	clienttypes.NewHeight(0, 9),
+	clienttypes.NewHeight(0, 10),
+}
+
+// heightPtr returns a pointer to the height.
+func heightPtr(revisionNumber, revisionHeight uint64) *clienttypes.Height {
+	height := clienttypes.NewHeight(revisionNumber, revisionHeight)
+	return &height
+}
+
+// newIndexedClientStore returns a client store with a consensus state whose timestamp is the revision height
+// at each of the heights.
+func newIndexedClientStore(t *testing.T) (sdk.KVStore, codec.BinaryCodec) {
+	ctx, clientStore, cdc := newTestContext(t, time.Unix(1, 0))
+	for _, h := range heights {
+		setConsensusState(clientStore, cdc, &ConsensusState{Timestamp: h.RevisionHeight}, h)
+		setConsensusMetadata(ctx, clientStore, h)
+	}
+	return clientStore, cdc
+}
+
+func TestIterateConsensusStates(t *testing.T) {
+	clientStore, cdc := newIndexedClientStore(t)
+
+	var ascending []clienttypes.Height
+	require.NoError(t, iterateConsensusStates(clientStore, cdc, func(h clienttypes.Height, cs *ConsensusState) bool {
+		require.Equal(t, h.RevisionHeight, cs.Timestamp)
+		ascending = append(ascending, h)
+		return false
+	}))
+	require.Equal(t, []clienttypes.Height{clienttypes.NewHeight(0, 9), clienttypes.NewHeight(0, 10), clienttypes.NewHeight(0, 100), clienttypes.NewHeight(1, 2)}, ascending)
+
+	var descending []clienttypes.Height
+	require.NoError(t, iterateConsensusStatesDescending(clientStore, cdc, func(h clienttypes.Height, _ *ConsensusState) bool {
+		descending = append(descending, h)
+		return len(descending) == 2
+	}))
+	require.Equal(t, []clienttypes.Height{clienttypes.NewHeight(1, 2), clienttypes.NewHeight(0, 100)}, descending)
+}
+
+func TestPreviousAndNextConsensusState(t *testing.T) {
+	clientStore, cdc := newIndexedClientStore(t)
+
+	testCases := []struct {
+		height     clienttypes.Height
+		prev, next *clienttypes.Height
+		atOrBefore *clienttypes.Height
+	}{
+		{clienttypes.NewHeight(0, 1), nil, heightPtr(0, 9), nil},
+		{clienttypes.NewHeight(0, 9), nil, heightPtr(0, 10), heightPtr(0, 9)},
+		{clienttypes.NewHeight(0, 50), heightPtr(0, 10), heightPtr(0, 100), heightPtr(0, 10)},
+		{clienttypes.NewHeight(0, 100), heightPtr(0, 10), heightPtr(1, 2), heightPtr(0, 100)},
+		{clienttypes.NewHeight(1, 5), heightPtr(1, 2), nil, heightPtr(1, 2)},
+	}
+
+	for _, tc := range testCases {
+		prevHeight, prev, found, err := getPreviousConsensusState(clientStore, cdc, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, tc.prev != nil, found, "previous of %s", tc.height)
+		if found {
+			require.Equal(t, *tc.prev, prevHeight)
+			require.Equal(t, tc.prev.RevisionHeight, prev.Timestamp)
+		}
+
+		nextHeight, next, found, err := getNextConsensusState(clientStore, cdc, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, tc.next != nil, found, "next of %s", tc.height)
+		if found {
+			require.Equal(t, *tc.next, nextHeight)
+			require.Equal(t, tc.next.RevisionHeight, next.Timestamp)
+		}
+
+		foundHeight, _, found, err := getConsensusStateAtOrBefore(clientStore, cdc, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, tc.atOrBefore != nil, found, "at or before %s", tc.height)
+		if found {
+			require.Equal(t, *tc.atOrBefore, foundHeight)
+		}
+	}
+}
+
+func TestPaginateConsensusStates(t *testing.T) {
+	clientStore, cdc := newIndexedClientStore(t)
+
+	paginate := func(pageReq *query.PageRequest) ([]clienttypes.Height, *query.PageResponse) {
+		var page []clienttypes.Height
+		pageRes, err := paginateConsensusStates(clientStore, cdc, pageReq, func(h clienttypes.Height, _ *ConsensusState) error {
+			page = append(page, h)
+			return nil
+		})
+		require.NoError(t, err)
+		return page, pageRes
+	}
+
+	page, pageRes := paginate(&query.PageRequest{Limit: 3, CountTotal: true})
+	require.Equal(t, []clienttypes.Height{clienttypes.NewHeight(0, 9), clienttypes.NewHeight(0, 10), clienttypes.NewHeight(0, 100)}, page)
+	require.Equal(t, uint64(4), pageRes.Total)
+	page, pageRes = paginate(&query.PageRequest{Key: pageRes.NextKey, Limit: 3})
+	require.Equal(t, []clienttypes.Height{clienttypes.NewHeight(1, 2)}, page)
+	require.Nil(t, pageRes.NextKey)
+
+	page, pageRes = paginate(&query.PageRequest{Limit: 2, Reverse: true})
+	require.Equal(t, []clienttypes.Height{clienttypes.NewHeight(1, 2), clienttypes.NewHeight(0, 100)}, page)
+	page, _ = paginate(&query.PageRequest{Key: pageRes.NextKey, Limit: 2, Reverse: true})
+	require.Equal(t, []clienttypes.Height{clienttypes.NewHeight(0, 10), clienttypes.NewHeight(0, 9)}, page)
+}
+
+func TestCorruptedIndex(t *testing.T) {
+	_, clientStore, cdc := newTestContext(t, time.Unix(1, 0))
+	height := clienttypes.NewHeight(0, 1)
+	setIterationKey(clientStore, height)
+
+	require.ErrorIs(t, iterateConsensusStates(clientStore, cdc, func(clienttypes.Height, *ConsensusState) bool { return false }), clienttypes.ErrConsensusStateNotFound)
+	_, _, _, err := getNextConsensusState(clientStore, cdc, clienttypes.ZeroHeight())
+	require.ErrorIs(t, err, clienttypes.ErrConsensusStateNotFound)
+
+	clientStore.Delete(IterationKey(height))
+	clientStore.Set(append([]byte(KeyIterateConsensusStatePrefix), 1), host.ConsensusStateKey(height))
+	_, _, _, err = getNextConsensusState(clientStore, cdc, clienttypes.ZeroHeight())
+	require.ErrorIs(t, err, ErrInvalidIterationKey)
+}
+
+func TestExportMetadataIncludesIterationKeys(t *testing.T) {
+	ctx, clientStore, cdc := newTestContext(t, time.Unix(1, 0))
+	height := clienttypes.NewHeight(0, 1)
+	setConsensusState(clientStore, cdc, &ConsensusState{Timestamp: 1}, height)
+	setConsensusMetadata(ctx, clientStore, height)
+
+	metadata := ClientState{}.ExportMetadata(clientStore)
+	require.Len(t, metadata, 3)
+	require.Equal(t, IterationKey(height), metadata[2].GetKey())
+	require.Equal(t, host.ConsensusStateKey(height), metadata[2].GetValue())
+}
+
+func TestGetProcessedHeight(t *testing.T) {
+	_, clientStore, _ := newTestContext(t, time.Unix(1, 0))
+	height := clienttypes.NewHeight(0, 1)
+
+	testCases := []struct {
+		name     string
+		value    []byte
+		expFound bool
+		expErr   error
+		expValue clienttypes.Height
+	}{
+		{"missing", nil, false, nil, clienttypes.Height{}},
+		{"binary", bigEndianHeightBytes(clienttypes.NewHeight(3, 45)), true, nil, clienttypes.NewHeight(3, 45)},
+		{"legacy", []byte("1-2"), true, nil, clienttypes.NewHeight(1, 2)},
+		{"legacy of 16 characters", []byte("1234567-12345678"), true, nil, clienttypes.NewHeight(1234567, 12345678)},
+		{"invalid legacy", []byte("1-2-3"), false, ErrInvalidProcessedHeight, clienttypes.Height{}},
+		{"invalid length", []byte("garbage"), false, ErrInvalidProcessedHeight, clienttypes.Height{}},
+	}
+
+	for _, tc := range testCases {
+		if tc.value == nil {
+			clientStore.Delete(ProcessedHeightKey(height))
+		} else {
+			clientStore.Set(ProcessedHeightKey(height), tc.value)
+		}
+
+		processedHeight, found, err := getProcessedHeight(clientStore, height)
+		require.Equal(t, tc.expFound, found, tc.name)
+		if tc.expErr != nil {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		if found {
+			require.Equal(t, tc.expValue, processedHeight, tc.name)
+		}
+	}
+}
+
+func TestVerifyDelayPeriodPassedWithInvalidProcessedHeight(t *testing.T) {
+	ctx, clientStore, _ := newTestContext(t, time.Unix(1, 0))
+	height := clienttypes.NewHeight(0, 1)
+	clientStore.Set(ProcessedHeightKey(height), []byte("garbage"))
+
+	require.ErrorIs(t, verifyDelayPeriodPassed(ctx, clientStore, height, 0, 1), ErrInvalidProcessedHeight)
+}
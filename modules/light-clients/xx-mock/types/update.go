@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,14 +11,23 @@ import (
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
-// VerifyClientMessage checks if the clientMessage is of type Header
+// VerifyClientMessage checks if the clientMessage is of type Header or Misbehaviour and verifies the message
 func (cs *ClientState) VerifyClientMessage(
 	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
 	clientMsg exported.ClientMessage,
 ) error {
+	if err := cs.checkClientMessageFault(clientMsg); err != nil {
+		return err
+	}
+
 	switch msg := clientMsg.(type) {
 	case *Header:
-		return cs.verifyHeader(ctx, clientStore, cdc, msg)
+		if err := cs.verifyHeader(ctx, clientStore, cdc, msg); err != nil {
+			return err
+		}
+		return cs.verifyHeaderTimestamp(ctx, clientStore, cdc, msg)
+	case *Misbehaviour:
+		return cs.verifyMisbehaviour(ctx, clientStore, cdc, msg)
 	default:
 		return clienttypes.ErrInvalidClientType
 	}
@@ -25,6 +35,10 @@ func (cs *ClientState) VerifyClientMessage(
 
 // verifyHeader returns an error if:
 // - header revision is not equal to latest header revision
+// - the client has an authority public key and the header is not signed by it
+// (by at least threshold of its signers for a multisig authority)
+// - the header rotates the authority to an unsupported public key
+// - the header has no commitment root while the client uses the ICS-23 commitment scheme
 func (cs *ClientState) verifyHeader(
 	ctx sdk.Context, clientStore sdk.KVStore, cdc codec.BinaryCodec,
 	header *Header,
@@ -37,6 +51,58 @@ func (cs *ClientState) verifyHeader(
 		)
 
 	}
+	if cs.CommitmentScheme == CommitmentSchemeICS23 && len(header.Root) == 0 {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "header at height %s must have a commitment root for the ICS-23 commitment scheme", header.Height)
+	}
+	return cs.verifySignature(header)
+}
+
+// verifyHeaderTimestamp returns an error if the header timestamp is not strictly greater than the timestamp
+// of the consensus state at the previous height and strictly less than the timestamp of the consensus state
+// at the next height, or if it is later than the block time plus the max clock drift.
+// The neighbours are not checked if a consensus state already exists at the header height, so that a conflicting
+// header is still detected as misbehaviour by CheckForMisbehaviour. The headers of a Misbehaviour are not checked.
+func (cs *ClientState) verifyHeaderTimestamp(
+	ctx sdk.Context, clientStore sdk.KVStore, cdc codec.BinaryCodec,
+	header *Header,
+) error {
+	if header.Timestamp == 0 {
+		return sdkerrors.Wrapf(ErrZeroHeaderTimestamp, "header at height %s", header.Height)
+	}
+
+	if _, found := getConsensusState(clientStore, cdc, header.GetHeight()); found {
+		return nil
+	}
+
+	prev, next, err := getNeighbouringConsensusStates(clientStore, cdc, header.Height)
+	if err != nil {
+		return err
+	}
+	if prev != nil && header.Timestamp <= prev.Timestamp {
+		return sdkerrors.Wrapf(
+			ErrNonMonotonicTimestamp,
+			"header timestamp %d at height %s must be greater than the timestamp %d of the previous consensus state",
+			header.Timestamp, header.Height, prev.Timestamp,
+		)
+	}
+	if next != nil && header.Timestamp >= next.Timestamp {
+		return sdkerrors.Wrapf(
+			ErrNonMonotonicTimestamp,
+			"header timestamp %d at height %s must be less than the timestamp %d of the next consensus state",
+			header.Timestamp, header.Height, next.Timestamp,
+		)
+	}
+
+	if cs.MaxClockDrift > 0 {
+		headerTime := time.Unix(0, int64(header.Timestamp))
+		if maxTime := ctx.BlockTime().Add(cs.MaxClockDrift); headerTime.After(maxTime) {
+			return sdkerrors.Wrapf(
+				ErrMaxClockDriftExceeded,
+				"header timestamp %s is after the block time %s plus the max clock drift %s",
+				headerTime.UTC(), ctx.BlockTime().UTC(), cs.MaxClockDrift,
+			)
+		}
+	}
 	return nil
 }
 
@@ -49,6 +115,9 @@ func (cs *ClientState) verifyHeader(
 // A list containing the updated consensus height is returned.
 // UpdateState must only be used to update within a single revision, thus header revision number and trusted height's revision
 // number must be the same. To update to a new revision, use a separate upgrade path
+// If the header carries a new authority public key, it replaces the current one.
+// The consensus states which are not retained by the pruning policy are pruned, and a consensus state at a past
+// height outside of the retention window is not stored, in which case no height is returned.
 func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) []exported.Height {
 	header, ok := clientMsg.(*Header)
 	if !ok {
@@ -56,8 +125,18 @@ func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, client
 	}
 
 	// check for duplicate update
-	if _, found := getConsensusState(clientStore, cdc, header.GetHeight()); found {
-		// perform no-op
+	if existingConsState, found := getConsensusState(clientStore, cdc, header.GetHeight()); found {
+		// a conflicting header must never overwrite the stored consensus state.
+		// This is normally caught by CheckForMisbehaviour before UpdateState is called.
+		if !existingConsState.IsEqual(*header.ConsensusState()) {
+			cs.UpdateStateOnMisbehaviour(ctx, cdc, clientStore, header)
+			return []exported.Height{}
+		}
+		// perform no-op, except for the rotation of the authority, which has been verified in VerifyClientMessage
+		if header.NewPublicKey != nil {
+			cs.PublicKey = header.NewPublicKey
+			setClientState(clientStore, cdc, &cs)
+		}
 		return []exported.Height{header.GetHeight()}
 	}
 
@@ -66,8 +145,17 @@ func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, client
 		cs.LatestHeight = height
 	}
 
-	consensusState := &ConsensusState{
-		Timestamp: header.Timestamp,
+	// rotate the authority, which has been verified in VerifyClientMessage
+	if header.NewPublicKey != nil {
+		cs.PublicKey = header.NewPublicKey
+	}
+
+	consensusState := header.ConsensusState()
+
+	// a consensus state at a past height outside of the retention window of the pruning policy is not stored
+	if !cs.retainsConsensusState(ctx, cdc, clientStore, height, consensusState.Timestamp) {
+		setClientState(clientStore, cdc, &cs)
+		return []exported.Height{}
 	}
 
 	// set client state, consensus state and asssociated metadata
@@ -75,11 +163,15 @@ func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, client
 	setConsensusState(clientStore, cdc, consensusState, header.GetHeight())
 	setConsensusMetadata(ctx, clientStore, header.GetHeight())
 
+	cs.pruneConsensusStates(ctx, cdc, clientStore)
+
 	return []exported.Height{height}
 }
 
-// UpdateStateOnMisbehaviour updates state upon misbehaviour, freezing the ClientState.
-// For Mock, misbehaviour isn't defined and so this function never be called.
+// UpdateStateOnMisbehaviour updates state upon misbehaviour, freezing the ClientState. This method should only be called when misbehaviour is detected
+// as it does not perform any misbehaviour checks.
 func (cs ClientState) UpdateStateOnMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, _ exported.ClientMessage) {
-	panic(fmt.Errorf("misbehaviour is unexpected"))
+	cs.FrozenHeight = FrozenHeight
+
+	setClientState(clientStore, cdc, &cs)
 }
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderValidateBasic(t *testing.T) {
+	require.NoError(t, Header{Height: clienttypes.NewHeight(0, 1), Timestamp: 1}.ValidateBasic())
+	require.ErrorIs(t, Header{Height: clienttypes.NewHeight(0, 1)}.ValidateBasic(), ErrZeroHeaderTimestamp)
+}
+
+func TestVerifyHeaderTimestamp(t *testing.T) {
+	blockTime := time.Unix(0, 1000)
+
+	testCases := []struct {
+		name          string
+		height        uint64
+		timestamp     uint64
+		maxClockDrift time.Duration
+		expErr        error
+	}{
+		{"between the neighbours", 15, 150, 0, nil},
+		{"below the lowest height", 5, 99, 0, nil},
+		{"above the highest height", 25, 201, 0, nil},
+		{"zero timestamp", 25, 0, 0, ErrZeroHeaderTimestamp},
+		{"equal to the previous timestamp", 15, 100, 0, ErrNonMonotonicTimestamp},
+		{"before the previous timestamp", 25, 150, 0, ErrNonMonotonicTimestamp},
+		{"equal to the next timestamp", 15, 200, 0, ErrNonMonotonicTimestamp},
+		{"after the next timestamp", 5, 101, 0, ErrNonMonotonicTimestamp},
+		{"within the max clock drift", 25, 1500, 500, nil},
+		{"beyond the max clock drift", 25, 1501, 500, ErrMaxClockDriftExceeded},
+		{"no max clock drift", 25, 1501, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, clientStore, cdc := newTestContext(t, blockTime)
+			storeConsensusStates(ctx, clientStore, cdc, map[uint64]uint64{10: 100, 20: 200})
+
+			cs := &ClientState{LatestHeight: clienttypes.NewHeight(0, 20), MaxClockDrift: tc.maxClockDrift}
+			header := &Header{Height: clienttypes.NewHeight(0, tc.height), Timestamp: tc.timestamp}
+			err := cs.verifyHeaderTimestamp(ctx, clientStore, cdc, header)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestClientStateValidateMaxClockDrift(t *testing.T) {
+	cs := NewClientState(clienttypes.NewHeight(0, 1))
+	cs.MaxClockDrift = -time.Second
+	require.ErrorIs(t, cs.Validate(), ErrInvalidMaxClockDrift)
+}
+
+func TestConflictingHeaderWithNonMonotonicTimestampFreezesClient(t *testing.T) {
+	ctx, clientStore, cdc := newTestContext(t, time.Unix(0, 1000))
+	storeConsensusStates(ctx, clientStore, cdc, map[uint64]uint64{10: 100, 20: 200})
+
+	cs := &ClientState{LatestHeight: clienttypes.NewHeight(0, 20)}
+	header := &Header{Height: clienttypes.NewHeight(0, 20), Timestamp: 50}
+	require.NoError(t, cs.VerifyClientMessage(ctx, cdc, clientStore, header))
+	require.True(t, cs.CheckForMisbehaviour(ctx, cdc, clientStore, header))
+
+	cs.UpdateStateOnMisbehaviour(ctx, cdc, clientStore, header)
+	require.Equal(t, exported.Frozen, getClientState(clientStore, cdc).Status(ctx, clientStore, cdc))
+}
+
+func TestMisbehaviourWithNonMonotonicTimestampFreezesClient(t *testing.T) {
+	ctx, clientStore, cdc := newTestContext(t, time.Unix(0, 1000))
+	storeConsensusStates(ctx, clientStore, cdc, map[uint64]uint64{10: 100, 20: 200})
+
+	cs := &ClientState{LatestHeight: clienttypes.NewHeight(0, 20)}
+	misbehaviour := NewMisbehaviour(
+		&Header{Height: clienttypes.NewHeight(0, 15), Timestamp: 150},
+		&Header{Height: clienttypes.NewHeight(0, 15), Timestamp: 300},
+	)
+	require.NoError(t, misbehaviour.ValidateBasic())
+	require.NoError(t, cs.VerifyClientMessage(ctx, cdc, clientStore, misbehaviour))
+	require.True(t, cs.CheckForMisbehaviour(ctx, cdc, clientStore, misbehaviour))
+
+	cs.UpdateStateOnMisbehaviour(ctx, cdc, clientStore, misbehaviour)
+	require.Equal(t, exported.Frozen, getClientState(clientStore, cdc).Status(ctx, clientStore, cdc))
+}
@@ -0,0 +1,167 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// VerifyUpgradeAndUpdateState checks if the upgraded client has been committed by the current client
+// It will zero out all client-specific fields (e.g. TrustingPeriod) and verify all data
+// in client state that must be the same across all valid Mock clients for the new chain.
+// The proofs are the membership proofs of the upgraded client and consensus state at the upgrade path,
+// which are verified in the same way as VerifyMembership.
+// VerifyUpgrade will return an error if:
+// - the upgradedClient is not a Mock ClientState
+// - the upgradedConsState is not a Mock ConsensusState
+// - the height of upgraded client is not greater than that of current client
+// - the proofs do not match the commitments of the upgraded client and consensus state
+func (cs ClientState) VerifyUpgradeAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
+	upgradedClient exported.ClientState, upgradedConsState exported.ConsensusState,
+	proofUpgradeClient, proofUpgradeConsState []byte,
+) error {
+	if len(cs.UpgradePath) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade client, no upgrade path set")
+	}
+
+	// last height of current counterparty chain must be client's latest height
+	lastHeight := cs.GetLatestHeight()
+
+	if !upgradedClient.GetLatestHeight().GT(lastHeight) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "upgraded client height %s must be at greater than current client height %s",
+			upgradedClient.GetLatestHeight(), lastHeight)
+	}
+
+	mockUpgradeClient, ok := upgradedClient.(*ClientState)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "upgraded client must be Mock client. expected: %T got: %T",
+			&ClientState{}, upgradedClient)
+	}
+	mockUpgradeConsState, ok := upgradedConsState.(*ConsensusState)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "upgraded consensus state must be Mock consensus state. expected %T, got: %T",
+			&ConsensusState{}, upgradedConsState)
+	}
+
+	// Must prove against latest consensus state to ensure we are verifying against latest upgrade plan
+	consState, found := getConsensusState(clientStore, cdc, lastHeight)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrConsensusStateNotFound, "could not retrieve consensus state for lastHeight")
+	}
+
+	// Verify client proof
+	path, bz, err := upgradedClientCommitment(cdc, cs.UpgradePath, upgradedClient, lastHeight)
+	if err != nil {
+		return err
+	}
+	if err := cs.verifyMembershipProof(cdc, consState, lastHeight, proofUpgradeClient, path, bz); err != nil {
+		return sdkerrors.Wrap(err, "client state proof failed")
+	}
+
+	// Verify consensus state proof
+	path, bz, err = upgradedConsStateCommitment(cdc, cs.UpgradePath, upgradedConsState, lastHeight)
+	if err != nil {
+		return err
+	}
+	if err := cs.verifyMembershipProof(cdc, consState, lastHeight, proofUpgradeConsState, path, bz); err != nil {
+		return sdkerrors.Wrap(err, "consensus state proof failed")
+	}
+
+	// Construct new client state and consensus state
+	// Relayer chosen client parameters are ignored.
+	// All chain-chosen parameters come from committed client, all client-chosen parameters
+	// come from current client.
+	newClientState := mockUpgradeClient.ZeroCustomFields().(*ClientState)
+	copyClientParams(newClientState, cs)
+
+	if err := newClientState.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "updated client state failed basic validation")
+	}
+
+	newConsState := &ConsensusState{
+		Timestamp: mockUpgradeConsState.Timestamp,
+		Root:      mockUpgradeConsState.Root,
+	}
+
+	setClientState(clientStore, cdc, newClientState)
+	setConsensusState(clientStore, cdc, newConsState, newClientState.LatestHeight)
+	setConsensusMetadata(ctx, clientStore, newClientState.LatestHeight)
+
+	return nil
+}
+
+// UpgradedClientProof returns the proof that VerifyUpgradeAndUpdateState expects for the upgraded client
+// committed at the given last height of the current client.
+func (cs ClientState) UpgradedClientProof(cdc codec.BinaryCodec, upgradedClient exported.ClientState, lastHeight exported.Height) ([]byte, error) {
+	path, bz, err := upgradedClientCommitment(cdc, cs.UpgradePath, upgradedClient, lastHeight)
+	if err != nil {
+		return nil, err
+	}
+	return cs.MembershipProof(lastHeight, path, bz)
+}
+
+// UpgradedConsStateProof returns the proof that VerifyUpgradeAndUpdateState expects for the upgraded consensus state
+// committed at the given last height of the current client.
+func (cs ClientState) UpgradedConsStateProof(cdc codec.BinaryCodec, upgradedConsState exported.ConsensusState, lastHeight exported.Height) ([]byte, error) {
+	path, bz, err := upgradedConsStateCommitment(cdc, cs.UpgradePath, upgradedConsState, lastHeight)
+	if err != nil {
+		return nil, err
+	}
+	return cs.MembershipProof(lastHeight, path, bz)
+}
+
+// upgradedClientCommitment returns the path and the value at which the upgraded client is committed.
+func upgradedClientCommitment(
+	cdc codec.BinaryCodec, upgradePath []string, upgradedClient exported.ClientState, lastHeight exported.Height,
+) (commitmenttypes.MerklePath, []byte, error) {
+	bz, err := cdc.MarshalInterface(upgradedClient.ZeroCustomFields())
+	if err != nil {
+		return commitmenttypes.MerklePath{}, nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "could not marshal client state: %v", err)
+	}
+	path, err := constructUpgradeMerklePath(upgradePath, lastHeight, upgradetypes.KeyUpgradedClient)
+	if err != nil {
+		return commitmenttypes.MerklePath{}, nil, err
+	}
+	return path, bz, nil
+}
+
+// upgradedConsStateCommitment returns the path and the value at which the upgraded consensus state is committed.
+func upgradedConsStateCommitment(
+	cdc codec.BinaryCodec, upgradePath []string, upgradedConsState exported.ConsensusState, lastHeight exported.Height,
+) (commitmenttypes.MerklePath, []byte, error) {
+	bz, err := cdc.MarshalInterface(upgradedConsState)
+	if err != nil {
+		return commitmenttypes.MerklePath{}, nil, sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "could not marshal consensus state: %v", err)
+	}
+	path, err := constructUpgradeMerklePath(upgradePath, lastHeight, upgradetypes.KeyUpgradedConsState)
+	if err != nil {
+		return commitmenttypes.MerklePath{}, nil, err
+	}
+	return path, bz, nil
+}
+
+// construct MerklePath for the committed client or consensus state from upgradePath
+func constructUpgradeMerklePath(upgradePath []string, lastHeight exported.Height, key string) (commitmenttypes.MerklePath, error) {
+	if len(upgradePath) == 0 {
+		return commitmenttypes.MerklePath{}, sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "no upgrade path set")
+	}
+
+	// copy all elements from upgradePath except final element
+	path := make([]string, len(upgradePath)-1)
+	copy(path, upgradePath)
+
+	// append lastHeight and the key to last key of upgradePath and use as lastKey of path
+	// this will create the key that is used to store client or consensus state in upgrade store
+	lastKey := upgradePath[len(upgradePath)-1]
+	appendedKey := fmt.Sprintf("%s/%d/%s", lastKey, lastHeight.GetRevisionHeight(), key)
+
+	path = append(path, appendedKey)
+	return commitmenttypes.NewMerklePath(path...), nil
+}
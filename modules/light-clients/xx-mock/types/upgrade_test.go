@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyUpgradeAndUpdateState(t *testing.T) {
+	upgradePath := []string{"upgrade", "upgradedIBCState"}
+
+	testCases := []struct {
+		name     string
+		malleate func(cs, upgradedClient *ClientState, proofUpgradedConsState *[]byte)
+		expErr   error
+	}{
+		{"success", func(_, _ *ClientState, _ *[]byte) {}, nil},
+		{
+			"invalid consensus state proof",
+			func(_, _ *ClientState, proofUpgradedConsState *[]byte) { (*proofUpgradedConsState)[0] ^= 1 },
+			ErrInvalidProof,
+		},
+		{
+			"upgraded height is not greater",
+			func(_, upgradedClient *ClientState, _ *[]byte) {
+				upgradedClient.LatestHeight = clienttypes.NewHeight(0, 10)
+			},
+			sdkerrors.ErrInvalidHeight,
+		},
+		{
+			"no upgrade path",
+			func(cs, _ *ClientState, _ *[]byte) { cs.UpgradePath = nil },
+			clienttypes.ErrInvalidUpgradeClient,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, clientStore, cdc := newTestContext(t, time.Unix(0, 1000))
+			cs := NewClientState(clienttypes.NewHeight(0, 10))
+			cs.UpgradePath = upgradePath
+			cs.TrustingPeriod = time.Hour
+			require.NoError(t, cs.Initialize(ctx, cdc, clientStore, &ConsensusState{Timestamp: 1}))
+
+			upgradedClient := NewClientState(clienttypes.NewHeight(1, 1))
+			upgradedClient.UpgradePath = upgradePath
+			upgradedClient.TrustingPeriod = time.Minute
+			upgradedConsState := &ConsensusState{Timestamp: 5}
+
+			proofUpgradedClient, err := cs.UpgradedClientProof(cdc, upgradedClient, cs.LatestHeight)
+			require.NoError(t, err)
+			proofUpgradedConsState, err := cs.UpgradedConsStateProof(cdc, upgradedConsState, cs.LatestHeight)
+			require.NoError(t, err)
+
+			tc.malleate(cs, upgradedClient, &proofUpgradedConsState)
+
+			err = cs.VerifyUpgradeAndUpdateState(ctx, cdc, clientStore, upgradedClient, upgradedConsState, proofUpgradedClient, proofUpgradedConsState)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Equal(t, cs.LatestHeight, getClientState(clientStore, cdc).LatestHeight)
+				return
+			}
+			require.NoError(t, err)
+
+			newClientState := getClientState(clientStore, cdc)
+			require.Equal(t, upgradedClient.LatestHeight, newClientState.LatestHeight)
+			require.Equal(t, upgradePath, newClientState.UpgradePath)
+			// the client-chosen parameters are kept from the current client
+			require.Equal(t, time.Hour, newClientState.TrustingPeriod)
+
+			consensusState, found := getConsensusState(clientStore, cdc, upgradedClient.LatestHeight)
+			require.True(t, found)
+			require.Equal(t, upgradedConsState, consensusState)
+		})
+	}
+}